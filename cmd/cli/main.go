@@ -9,15 +9,19 @@ import (
 	"context"
 	"encoding/json"
 	"flag"
+	"fmt"
 	"io/ioutil"
 	"log"
 	"os"
 	"regexp"
 	"strconv"
+	"time"
 
 	"github.com/google/go-github/v42/github"
 	"github.com/reviewpad/reviewpad/v3"
 	"github.com/reviewpad/reviewpad/v3/collector"
+	"github.com/reviewpad/reviewpad/v3/engine"
+	"github.com/reviewpad/reviewpad/v3/lang/aladino"
 	"github.com/shurcooL/githubv4"
 	"golang.org/x/oauth2"
 )
@@ -29,9 +33,12 @@ var (
 	gitHubToken    = flag.String("github-token", "", "GitHub token")
 	eventFilePath  = flag.String("event-payload", "", "File path to github action event in JSON format")
 	mixpanelToken  = flag.String("mixpanel-token", "", "Mixpanel token")
+	now            = flag.String("now", "", "Current time for relative timestamps in RFC3339 format (e.g. 2022-04-05T10:00:00Z)")
+	planFormat     = flag.String("plan-format", engine.PLAN_TEXT_FORMAT, "Format of the plan printed in dry run mode (text or json)")
 )
 
 func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n  %[1]v [flags]\n  %[1]v [flags] fmt [file ...]\n\nFlags:\n", os.Args[0])
 	flag.PrintDefaults()
 	os.Exit(2)
 }
@@ -49,7 +56,71 @@ func parseEvent(rawEvent string) (interface{}, error) {
 		return nil, err
 	}
 
-	return github.ParseWebHook(*ev.Name, *ev.Payload)
+	payload, err := github.ParseWebHook(*ev.Name, *ev.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	// The JSON of the payload is kept to read the fields that the parsed payload does not have
+	return &aladino.RawEventPayload{Payload: payload, JSON: *ev.Payload}, nil
+}
+
+func parseClock(now string) (aladino.Clock, error) {
+	if now == "" {
+		return aladino.NewSystemClock(), nil
+	}
+
+	nowTime, err := time.Parse(time.RFC3339, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return aladino.NewFixedClock(nowTime), nil
+}
+
+// formatFiles rewrites the specs and actions of the reviewpad files in their canonical form.
+// When no files are given, it rewrites the file of the reviewpad argument.
+func formatFiles(files []string) {
+	if len(files) == 0 {
+		if *reviewpadFile == "" {
+			log.Printf("Missing argument reviewpad.")
+			usage()
+		}
+
+		files = []string{*reviewpadFile}
+	}
+
+	for _, file := range files {
+		info, err := os.Stat(file)
+		if err != nil {
+			log.Fatalf("Error reading reviewpad file. Details: %v", err.Error())
+		}
+
+		data, err := os.ReadFile(file)
+		if err != nil {
+			log.Fatalf("Error reading reviewpad file. Details: %v", err.Error())
+		}
+
+		formatted, warnings, err := reviewpad.Format(data)
+		if err != nil {
+			log.Fatalf("Error formatting reviewpad file %v. Details: %v", file, err.Error())
+		}
+
+		for _, warning := range warnings {
+			log.Printf("Warning on reviewpad file %v: %v", file, warning)
+		}
+
+		if bytes.Equal(data, formatted) {
+			continue
+		}
+
+		err = os.WriteFile(file, formatted, info.Mode().Perm())
+		if err != nil {
+			log.Fatalf("Error writing reviewpad file %v. Details: %v", file, err.Error())
+		}
+
+		log.Printf("Formatted %v", file)
+	}
 }
 
 func main() {
@@ -59,6 +130,11 @@ func main() {
 		usage()
 	}
 
+	if flag.Arg(0) == "fmt" {
+		formatFiles(flag.Args()[1:])
+		return
+	}
+
 	if *reviewpadFile == "" {
 		log.Printf("Missing argument reviewpad.")
 		usage()
@@ -74,6 +150,11 @@ func main() {
 		usage()
 	}
 
+	if *planFormat != engine.PLAN_TEXT_FORMAT && *planFormat != engine.PLAN_JSON_FORMAT {
+		log.Printf("Invalid argument plan-format %v.", *planFormat)
+		usage()
+	}
+
 	var rawEvent string
 
 	if *eventFilePath == "" {
@@ -92,6 +173,11 @@ func main() {
 		log.Fatal(err)
 	}
 
+	clock, err := parseClock(*now)
+	if err != nil {
+		log.Fatalf("Error parsing now. Details %v", err.Error())
+	}
+
 	pullRequestDetailsRegex := regexp.MustCompile(`github\.com\/(.+)\/(.+)\/pull\/(\d+)`)
 	pullRequestDetails := pullRequestDetailsRegex.FindSubmatch([]byte(*pullRequestUrl))
 
@@ -155,7 +241,22 @@ func main() {
 		log.Fatalf("Error running reviewpad team edition. Details %v", err.Error())
 	}
 
-	_, err = reviewpad.Run(ctx, gitHubClient, gitHubClientGQL, collectorClient, ghPullRequest, ev, file, *dryRun)
+	if *dryRun {
+		plan, err := reviewpad.Plan(ctx, gitHubClient, gitHubClientGQL, collectorClient, ghPullRequest, ev, file, clock)
+		if err != nil {
+			log.Fatalf("Error planning reviewpad team edition. Details %v", err.Error())
+		}
+
+		output, err := plan.Render(*planFormat)
+		if err != nil {
+			log.Fatalf("Error rendering plan. Details %v", err.Error())
+		}
+
+		fmt.Println(output)
+		return
+	}
+
+	_, err = reviewpad.RunWithClock(ctx, gitHubClient, gitHubClientGQL, collectorClient, ghPullRequest, ev, file, *dryRun, clock)
 	if err != nil {
 		log.Fatalf("Error running reviewpad team edition. Details %v", err.Error())
 	}
@@ -6,6 +6,7 @@ package engine
 
 import (
 	"context"
+	"errors"
 
 	"github.com/google/go-github/v42/github"
 	"github.com/reviewpad/reviewpad/v3/collector"
@@ -20,15 +21,44 @@ const GroupTypeStatic GroupType = "static"
 const GroupTypeFilter GroupType = "filter"
 
 type Interpreter interface {
+	ProcessCompiled(compiled Compiled) error
+	ProcessFunction(spec string) error
 	ProcessGroup(name string, kind GroupKind, typeOf GroupType, expr, paramExpr, whereExpr string) error
 	ProcessLabel(id, name string) error
 	ProcessRule(name, spec string) error
 	EvalExpr(kind, expr string) (bool, error)
 	ExecProgram(program *Program) error
 	ExecStatement(statement *Statement) error
+	DescribeStatement(statement *Statement) (string, error)
 	Report(mode string) error
 }
 
+// TypeChecker statically checks the specs and actions of a reviewpad file.
+// It only relies on the built-ins signatures and never reaches GitHub.
+// The line is where the spec or action is declared in the reviewpad file (0 when unknown).
+type TypeChecker interface {
+	TypeCheckFunction(function PadFunction, line int) error
+	TypeCheckGroup(group PadGroup, line int) error
+	TypeCheckRule(rule PadRule, line int) error
+	TypeCheckAction(workflowName, action string, line int) error
+	// Compiled returns the specs and actions that were type checked in the form that the interpreter runs
+	Compiled() Compiled
+}
+
+// Compiled is the form of the specs and actions of a reviewpad file that an interpreter runs (e.g. type checked ASTs).
+// It is built once when the file is linted, so the runs of the file over many pull requests do not compile it again.
+// Its content is specific to the language of the specs.
+type Compiled interface{}
+
+// Formatter rewrites the specs and actions of a reviewpad file in their canonical form.
+type Formatter interface {
+	FormatFunction(spec string) (string, error)
+	FormatExpr(spec string) (string, error)
+}
+
+// ErrSpecHasComments is returned by a Formatter when the canonical form of a spec would lose its comments.
+var ErrSpecHasComments = errors.New("spec has comments")
+
 type Env struct {
 	Ctx          context.Context
 	DryRun       bool
@@ -31,7 +31,7 @@ func CollectError(env *Env, err error) {
 }
 
 // Eval: main function that generates the program to be executed
-// Pre-condition Lint(file) == nil
+// Pre-condition Lint(file, typeChecker) == nil
 func Eval(file *ReviewpadFile, env *Env) (*Program, error) {
 	execLogf("file to evaluate:\n%+v", file)
 
@@ -46,6 +46,7 @@ func Eval(file *ReviewpadFile, env *Env) (*Program, error) {
 		"version":        file.Version,
 		"edition":        file.Edition,
 		"mode":           file.Mode,
+		"totalFunctions": len(file.Functions),
 		"totalGroups":    len(file.Groups),
 		"totalLabels":    len(file.Labels),
 		"totalRules":     len(file.Rules),
@@ -54,11 +55,20 @@ func Eval(file *ReviewpadFile, env *Env) (*Program, error) {
 
 	rules := make(map[string]PadRule)
 
+	execLogf("detected %v functions", len(file.Functions))
 	execLogf("detected %v groups", len(file.Groups))
 	execLogf("detected %v labels", len(file.Labels))
 	execLogf("detected %v rules", len(file.Rules))
 	execLogf("detected %v workflows", len(file.Workflows))
 
+	// reuse the specs and actions compiled when the file was linted
+	if file.compiled != nil {
+		err := interpreter.ProcessCompiled(file.compiled)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// process labels
 	for labelKeyName, label := range file.Labels {
 		labelName := labelKeyName
@@ -88,6 +98,16 @@ func Eval(file *ReviewpadFile, env *Env) (*Program, error) {
 		}
 	}
 
+	// process functions
+	// functions come first since they can be called by groups, rules and actions
+	for _, function := range file.Functions {
+		err := interpreter.ProcessFunction(function.Spec)
+		if err != nil {
+			CollectError(env, err)
+			return nil, err
+		}
+	}
+
 	// process groups
 	for _, group := range file.Groups {
 		err := interpreter.ProcessGroup(group.Name, GroupKind(group.Kind), GroupType(group.Type), group.Spec, group.Param, group.Where)
@@ -109,7 +129,8 @@ func Eval(file *ReviewpadFile, env *Env) (*Program, error) {
 
 	// a program is a list of statements to be executed based on the workflow rules and actions.
 	program := &Program{
-		Statements: make([]*Statement, 0),
+		Statements:   make([]*Statement, 0),
+		IgnoreErrors: file.IgnoreErrors,
 	}
 
 	// triggeredExclusiveWorkflow is a control variable to denote if a workflow `always-run: false` has been triggered.
@@ -0,0 +1,458 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package engine
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/reviewpad/reviewpad/v3/utils/fmtio"
+	"gopkg.in/yaml.v3"
+)
+
+func formatError(format string, a ...interface{}) error {
+	return fmtio.Errorf("format", format, a...)
+}
+
+// FormatWarning is a spec that Format kept as written.
+type FormatWarning struct {
+	Line    int
+	Message string
+}
+
+func (w *FormatWarning) String() string {
+	return fmtio.Sprintf("format", "line %v: %v", w.Line, w.Message)
+}
+
+// formatSpec is a spec of a reviewpad file along with the function that formats it.
+type formatSpec struct {
+	node *yaml.Node
+	// inFlow tells if the spec is in a flow collection (e.g. [$merge()])
+	inFlow bool
+	format func(spec string) (string, error)
+}
+
+// formatEdit replaces the bytes of data[start:end] with text.
+type formatEdit struct {
+	start int
+	end   int
+	text  string
+}
+
+// Format rewrites the specs and actions of a reviewpad file in their canonical form.
+// Only the specs are rewritten: the rest of the file, including its comments and blank lines, is kept as written.
+// The specs that cannot be rewritten without losing information (e.g. the comments in a spec) are kept as written
+// and reported in the warnings.
+// Imports are not inlined since only the given file is rewritten.
+func Format(data []byte, formatter Formatter) ([]byte, []*FormatWarning, error) {
+	var root yaml.Node
+	err := yaml.Unmarshal(data, &root)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Empty file
+	if len(root.Content) == 0 {
+		return data, nil, nil
+	}
+
+	source := newYAMLSource(data)
+	specs := fileSpecs(root.Content[0], formatter)
+	wantValues := make([]string, len(specs))
+	edits := make([]*formatEdit, 0)
+	warnings := make([]*FormatWarning, 0)
+
+	for i, spec := range specs {
+		wantValues[i] = spec.node.Value
+
+		if strings.TrimSpace(spec.node.Value) == "" {
+			continue
+		}
+
+		formatted, err := spec.format(spec.node.Value)
+		if errors.Is(err, ErrSpecHasComments) {
+			warnings = append(warnings, &FormatWarning{
+				Line:    valueLine(spec.node),
+				Message: "spec kept as written since formatting it would remove its comments",
+			})
+			continue
+		}
+
+		if err != nil {
+			return nil, nil, formatError("line %v: %v", valueLine(spec.node), err)
+		}
+
+		// Block scalars keep their final line break
+		if strings.HasSuffix(spec.node.Value, "\n") {
+			formatted += "\n"
+		}
+
+		if formatted == spec.node.Value {
+			continue
+		}
+
+		edit, ok := source.replaceScalar(spec.node, spec.inFlow, formatted)
+		if !ok {
+			warnings = append(warnings, &FormatWarning{
+				Line:    valueLine(spec.node),
+				Message: "spec kept as written since its YAML layout is not supported",
+			})
+			continue
+		}
+
+		wantValues[i] = formatted
+		edits = append(edits, edit)
+	}
+
+	formattedData := source.apply(edits)
+
+	err = checkFormat(formattedData, formatter, wantValues)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return formattedData, warnings, nil
+}
+
+// checkFormat checks that the formatted file has the formatted specs,
+// so that a spec that was not written back correctly never goes unnoticed.
+func checkFormat(data []byte, formatter Formatter, wantValues []string) error {
+	var root yaml.Node
+	err := yaml.Unmarshal(data, &root)
+	if err != nil {
+		return formatError("unable to write the formatted specs: %v", err)
+	}
+
+	specs := fileSpecs(root.Content[0], formatter)
+	if len(specs) != len(wantValues) {
+		return formatError("unable to write the formatted specs")
+	}
+
+	for i, spec := range specs {
+		if strings.TrimRight(spec.node.Value, "\n") != strings.TrimRight(wantValues[i], "\n") {
+			return formatError("line %v: unable to write the formatted spec", valueLine(spec.node))
+		}
+	}
+
+	return nil
+}
+
+// fileSpecs returns the specs of the functions, groups, rules and workflows of a reviewpad file in the order they are written.
+func fileSpecs(file *yaml.Node, formatter Formatter) []*formatSpec {
+	specs := make([]*formatSpec, 0)
+
+	mappingSpec := func(node *yaml.Node, key string, format func(spec string) (string, error)) {
+		if value := mappingValue(node, key); value != nil && value.Kind == yaml.ScalarNode {
+			specs = append(specs, &formatSpec{value, isFlow(node), format})
+		}
+	}
+
+	sequenceSpecs := func(node *yaml.Node, format func(spec string) (string, error)) {
+		for _, elem := range sequenceElems(node) {
+			if elem.Kind == yaml.ScalarNode {
+				specs = append(specs, &formatSpec{elem, isFlow(node), format})
+			}
+		}
+	}
+
+	for _, function := range sequenceElems(mappingValue(file, "functions")) {
+		mappingSpec(function, "spec", formatter.FormatFunction)
+	}
+
+	for _, group := range sequenceElems(mappingValue(file, "groups")) {
+		mappingSpec(group, "spec", formatter.FormatExpr)
+		mappingSpec(group, "where", formatter.FormatExpr)
+	}
+
+	for _, rule := range sequenceElems(mappingValue(file, "rules")) {
+		mappingSpec(rule, "spec", formatter.FormatExpr)
+	}
+
+	for _, workflow := range sequenceElems(mappingValue(file, "workflows")) {
+		for _, workflowRule := range sequenceElems(mappingValue(workflow, "if")) {
+			sequenceSpecs(mappingValue(workflowRule, "extra-actions"), formatter.FormatExpr)
+		}
+
+		sequenceSpecs(mappingValue(workflow, "then"), formatter.FormatExpr)
+	}
+
+	return specs
+}
+
+// sequenceElems returns the elements of a YAML sequence (nil when node is not a sequence).
+func sequenceElems(node *yaml.Node) []*yaml.Node {
+	if node == nil || node.Kind != yaml.SequenceNode {
+		return nil
+	}
+
+	return node.Content
+}
+
+func isFlow(node *yaml.Node) bool {
+	return node.Style&yaml.FlowStyle != 0
+}
+
+// yamlSource is the text of a YAML document where the nodes are located by line and column.
+type yamlSource struct {
+	data string
+	// lineStarts is the offset of the start of each line
+	lineStarts []int
+}
+
+func newYAMLSource(data []byte) *yamlSource {
+	lineStarts := []int{0}
+	for i, c := range data {
+		if c == '\n' {
+			lineStarts = append(lineStarts, i+1)
+		}
+	}
+
+	return &yamlSource{string(data), lineStarts}
+}
+
+// offset returns the offset of a line and a column, where the column counts characters.
+func (s *yamlSource) offset(line, column int) (int, bool) {
+	if line < 1 || line > len(s.lineStarts) || column < 1 {
+		return 0, false
+	}
+
+	start := s.lineStarts[line-1]
+	end := s.lineEnd(start)
+
+	chars := 1
+	for i := range s.data[start:end] {
+		if chars == column {
+			return start + i, true
+		}
+		chars++
+	}
+
+	return 0, false
+}
+
+// lineEnd returns the offset of the line break of the line at offset (or the end of the data).
+func (s *yamlSource) lineEnd(offset int) int {
+	end := strings.IndexByte(s.data[offset:], '\n')
+	if end == -1 {
+		return len(s.data)
+	}
+
+	return offset + end
+}
+
+// apply returns the data with the edits, which do not overlap.
+func (s *yamlSource) apply(edits []*formatEdit) []byte {
+	sort.Slice(edits, func(i, j int) bool {
+		return edits[i].start < edits[j].start
+	})
+
+	var buf bytes.Buffer
+
+	last := 0
+	for _, edit := range edits {
+		buf.WriteString(s.data[last:edit.start])
+		buf.WriteString(edit.text)
+		last = edit.end
+	}
+	buf.WriteString(s.data[last:])
+
+	return buf.Bytes()
+}
+
+// replaceScalar returns the edit that replaces the value of a scalar node, keeping its style.
+// Scalars with anchors, tags or an explicit indentation are not supported.
+func (s *yamlSource) replaceScalar(node *yaml.Node, inFlow bool, value string) (*formatEdit, bool) {
+	start, ok := s.offset(node.Line, node.Column)
+	if !ok {
+		return nil, false
+	}
+
+	switch {
+	case node.Style == yaml.LiteralStyle && s.data[start] == '|':
+		return s.replaceBlockScalar(start, value)
+	case node.Style == yaml.FoldedStyle && s.data[start] == '>':
+		// The line breaks of a folded scalar are read as spaces
+		if strings.Contains(strings.TrimRight(value, "\n"), "\n") {
+			return nil, false
+		}
+
+		return s.replaceBlockScalar(start, value)
+	case node.Style == yaml.DoubleQuotedStyle && s.data[start] == '"':
+		end, ok := s.quotedEnd(start)
+		if !ok {
+			return nil, false
+		}
+
+		return &formatEdit{start, end, doubleQuoted(value)}, true
+	case node.Style == yaml.SingleQuotedStyle && s.data[start] == '\'':
+		end, ok := s.quotedEnd(start)
+		if !ok || strings.Contains(value, "\n") {
+			return nil, false
+		}
+
+		return &formatEdit{start, end, singleQuoted(value)}, true
+	case node.Style == 0 && !strings.ContainsRune("&!*|>'\"", rune(s.data[start])):
+		end, ok := s.plainEnd(start, inFlow, node.Value)
+		if !ok {
+			return nil, false
+		}
+
+		return &formatEdit{start, end, plain(value, inFlow)}, true
+	}
+
+	return nil, false
+}
+
+// replaceBlockScalar returns the edit that replaces the lines of a block scalar whose header (e.g. |) is at start.
+// The lines of the value have the indentation of the first line of the block.
+func (s *yamlSource) replaceBlockScalar(start int, value string) (*formatEdit, bool) {
+	headerEnd := s.lineEnd(start)
+	header := strings.SplitN(s.data[start:headerEnd], "#", 2)[0]
+	if strings.ContainsAny(header, "123456789") || headerEnd == len(s.data) {
+		return nil, false
+	}
+
+	contentStart := headerEnd + 1
+	contentEnd := contentStart
+	indent := 0
+
+	for offset := contentStart; offset < len(s.data); offset = s.lineEnd(offset) + 1 {
+		line := s.data[offset:s.lineEnd(offset)]
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		lineIndent := len(line) - len(strings.TrimLeft(line, " "))
+		if indent == 0 {
+			indent = lineIndent
+		} else if lineIndent < indent {
+			break
+		}
+
+		contentEnd = s.lineEnd(offset)
+	}
+
+	if indent == 0 {
+		return nil, false
+	}
+
+	lines := strings.Split(strings.TrimRight(value, "\n"), "\n")
+	for i, line := range lines {
+		lines[i] = strings.Repeat(" ", indent) + line
+	}
+
+	return &formatEdit{contentStart, contentEnd, strings.Join(lines, "\n")}, true
+}
+
+// quotedEnd returns the offset after the closing quote of the quoted scalar at start.
+func (s *yamlSource) quotedEnd(start int) (int, bool) {
+	quote := s.data[start]
+
+	for i := start + 1; i < len(s.data); i++ {
+		switch {
+		case s.data[i] == '\\' && quote == '"':
+			i++
+		case s.data[i] == '\'' && quote == '\'' && i+1 < len(s.data) && s.data[i+1] == '\'':
+			i++
+		case s.data[i] == quote:
+			return i + 1, true
+		}
+	}
+
+	return 0, false
+}
+
+// plainEnd returns the offset after the plain scalar at start, which can span several lines.
+func (s *yamlSource) plainEnd(start int, inFlow bool, value string) (int, bool) {
+	offset := start
+	parts := make([]string, 0)
+
+	for {
+		line := s.data[offset:s.lineEnd(offset)]
+		text := strings.TrimLeft(line, " \t")
+		indent := len(line) - len(text)
+
+		text = plainText(text, inFlow)
+		if text == "" {
+			return 0, false
+		}
+
+		parts = append(parts, text)
+		folded := strings.Join(parts, " ")
+
+		if folded == value {
+			return offset + indent + len(text), true
+		}
+
+		if len(folded) >= len(value) || s.lineEnd(offset) == len(s.data) {
+			return 0, false
+		}
+
+		offset = s.lineEnd(offset) + 1
+	}
+}
+
+// plainText returns the part of a line that belongs to a plain scalar, which stops at a comment
+// or, in a flow collection, at a flow indicator.
+func plainText(line string, inFlow bool) string {
+	for i := 0; i < len(line); i++ {
+		if line[i] == '#' && i > 0 && (line[i-1] == ' ' || line[i-1] == '\t') {
+			line = line[:i]
+			break
+		}
+
+		if inFlow && strings.IndexByte(",[]{}", line[i]) != -1 {
+			line = line[:i]
+			break
+		}
+	}
+
+	return strings.TrimRight(line, " \t\r")
+}
+
+func doubleQuoted(value string) string {
+	var buf bytes.Buffer
+
+	// A JSON string is a valid YAML double-quoted scalar
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	// Encoding a string never fails
+	_ = encoder.Encode(value)
+
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+func singleQuoted(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", "''") + "'"
+}
+
+// plain returns the value as a plain scalar when it is valid and quoted otherwise.
+func plain(value string, inFlow bool) string {
+	if strings.Contains(value, "\n") {
+		return doubleQuoted(value)
+	}
+
+	var buf bytes.Buffer
+
+	encoder := yaml.NewEncoder(&buf)
+	err := encoder.Encode(&yaml.Node{Kind: yaml.ScalarNode, Value: value})
+	if err != nil {
+		return doubleQuoted(value)
+	}
+
+	text := strings.TrimSuffix(buf.String(), "\n")
+	if strings.Contains(text, "\n") {
+		return doubleQuoted(value)
+	}
+
+	if inFlow && text == value && strings.ContainsAny(value, ",[]{}") {
+		return singleQuoted(value)
+	}
+
+	return text
+}
@@ -0,0 +1,187 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// spacesFormatter removes the spaces of the specs and marks the kind of spec it formatted.
+type spacesFormatter struct{}
+
+func (f *spacesFormatter) FormatFunction(spec string) (string, error) {
+	return fmt.Sprintf("function:%v", strings.ReplaceAll(strings.TrimSpace(spec), " ", "")), nil
+}
+
+func (f *spacesFormatter) FormatExpr(spec string) (string, error) {
+	if strings.Contains(spec, "invalid") {
+		return "", errors.New("invalid spec")
+	}
+
+	if strings.Contains(spec, "#") {
+		return "", ErrSpecHasComments
+	}
+
+	return fmt.Sprintf("expr:%v", strings.ReplaceAll(strings.TrimSpace(spec), " ", "")), nil
+}
+
+func TestFormat(t *testing.T) {
+	formatted, warnings, err := Format([]byte(mockedReviewpadFileWithLines), &spacesFormatter{})
+
+	wantFormatted := `
+functions:
+  - spec: 'function:touches(path:String):Bool=$hasFilePattern($path)'
+
+groups:
+  - name: seniors
+    spec: 'expr:["john"]'
+  - name: juniors
+    type: filter
+    param: dev
+    where: |
+      expr:$totalCreatedPullRequests($dev)<10
+
+rules:
+  - name: is-small
+    kind: patch
+    spec: expr:$size()<=30
+
+workflows:
+  - name: add-label-with-size
+    if:
+      - rule: is-small
+        extra-actions:
+          - 'expr:$addLabel("small")'
+    then:
+      - 'expr:$addLabel("ship")'
+      - 'expr:$merge("rebase")'
+`
+
+	assert.Nil(t, err)
+	assert.Empty(t, warnings)
+	assert.Equal(t, wantFormatted, string(formatted))
+}
+
+func TestFormat_KeepsComments(t *testing.T) {
+	data := `# reviewpad configuration
+rules:
+  # small pull requests
+  - name: is-small
+    kind: patch
+    spec: $size() <= 30 # lines
+`
+
+	formatted, warnings, err := Format([]byte(data), &spacesFormatter{})
+
+	wantFormatted := `# reviewpad configuration
+rules:
+  # small pull requests
+  - name: is-small
+    kind: patch
+    spec: expr:$size()<=30 # lines
+`
+
+	assert.Nil(t, err)
+	assert.Empty(t, warnings)
+	assert.Equal(t, wantFormatted, string(formatted))
+}
+
+func TestFormat_KeepsTheQuotingOfSpecs(t *testing.T) {
+	data := `rules:
+  - name: is-small
+    kind: patch
+    spec: "$size()  <= 30"
+  - name: is-large
+    kind: patch
+    spec: >
+      $size()
+      > 300
+workflows:
+  - name: label
+    then: [ $addLabel( "a" ), '$assign("a", "b")' ]
+`
+
+	formatted, warnings, err := Format([]byte(data), &spacesFormatter{})
+
+	wantFormatted := `rules:
+  - name: is-small
+    kind: patch
+    spec: "expr:$size()<=30"
+  - name: is-large
+    kind: patch
+    spec: >
+      expr:$size()>300
+workflows:
+  - name: label
+    then: [ expr:$addLabel("a"), 'expr:$assign("a","b")' ]
+`
+
+	assert.Nil(t, err)
+	assert.Empty(t, warnings)
+	assert.Equal(t, wantFormatted, string(formatted))
+}
+
+func TestFormat_WhenSpecHasComments(t *testing.T) {
+	data := `rules:
+  - name: is-small
+    kind: patch
+    spec: |
+      # small pull requests
+      $size() <= 30
+  - name: is-large
+    kind: patch
+    spec: $size() > 300
+`
+
+	formatted, warnings, err := Format([]byte(data), &spacesFormatter{})
+
+	wantFormatted := `rules:
+  - name: is-small
+    kind: patch
+    spec: |
+      # small pull requests
+      $size() <= 30
+  - name: is-large
+    kind: patch
+    spec: expr:$size()>300
+`
+
+	wantWarnings := []*FormatWarning{
+		{
+			Line:    5,
+			Message: "spec kept as written since formatting it would remove its comments",
+		},
+	}
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantWarnings, warnings)
+	assert.Equal(t, "[format] line 5: spec kept as written since formatting it would remove its comments", warnings[0].String())
+	assert.Equal(t, wantFormatted, string(formatted))
+}
+
+func TestFormat_WhenSpecIsInvalid(t *testing.T) {
+	data := `rules:
+  - name: is-small
+    kind: patch
+    spec: invalid
+`
+
+	_, _, err := Format([]byte(data), &spacesFormatter{})
+
+	assert.EqualError(t, err, "[format] line 4: invalid spec")
+}
+
+func TestFormat_WhenFileIsEmpty(t *testing.T) {
+	formatted, warnings, err := Format([]byte(""), &spacesFormatter{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, warnings)
+	assert.Equal(t, "", string(formatted))
+}
@@ -24,6 +24,7 @@ type PadRule struct {
 	Kind        string `yaml:"kind"`
 	Description string `yaml:"description"`
 	Spec        string `yaml:"spec"`
+	specLine    int
 }
 
 func (p PadRule) equals(o PadRule) bool {
@@ -48,9 +49,30 @@ func (p PadRule) equals(o PadRule) bool {
 
 var kinds = []string{"patch", "author"}
 
+// PadFunction is a user-defined function, e.g. touches(path: String): Bool = $hasFilePattern($path)
+type PadFunction struct {
+	Description string `yaml:"description"`
+	Spec        string `yaml:"spec"`
+	specLine    int
+}
+
+func (p PadFunction) equals(o PadFunction) bool {
+	if p.Description != o.Description {
+		return false
+	}
+
+	if p.Spec != o.Spec {
+		return false
+	}
+
+	return true
+}
+
 type PadWorkflowRule struct {
 	Rule         string   `yaml:"rule"`
 	ExtraActions []string `yaml:"extra-actions"`
+	// lines of the extra actions in the reviewpad file
+	extraActionsLines []int
 }
 
 func (p PadWorkflowRule) equals(o PadWorkflowRule) bool {
@@ -99,6 +121,8 @@ type PadWorkflow struct {
 	AlwaysRun   bool              `yaml:"always-run"`
 	Rules       []PadWorkflowRule `yaml:"if"`
 	Actions     []string          `yaml:"then"`
+	// lines of the actions in the reviewpad file
+	actionsLines []int
 }
 
 func (p PadWorkflow) equals(o PadWorkflow) bool {
@@ -147,6 +171,8 @@ type PadGroup struct {
 	Spec        string `yaml:"spec"`
 	Param       string `yaml:"param"`
 	Where       string `yaml:"where"`
+	specLine    int
+	whereLine   int
 }
 
 func (p PadGroup) equals(o PadGroup) bool {
@@ -183,10 +209,13 @@ type ReviewpadFile struct {
 	Mode         string              `yaml:"mode"`
 	IgnoreErrors bool                `yaml:"ignore-errors"`
 	Imports      []PadImport         `yaml:"imports"`
+	Functions    []PadFunction       `yaml:"functions"`
 	Groups       []PadGroup          `yaml:"groups"`
 	Rules        []PadRule           `yaml:"rules"`
 	Labels       map[string]PadLabel `yaml:"labels"`
 	Workflows    []PadWorkflow       `yaml:"workflows"`
+	// compiled are the specs and actions compiled when the file was linted (nil when it was not linted)
+	compiled Compiled
 }
 
 func (r *ReviewpadFile) equals(o *ReviewpadFile) bool {
@@ -216,6 +245,16 @@ func (r *ReviewpadFile) equals(o *ReviewpadFile) bool {
 		}
 	}
 
+	if len(r.Functions) != len(o.Functions) {
+		return false
+	}
+	for i, rF := range r.Functions {
+		oF := o.Functions[i]
+		if !rF.equals(oF) {
+			return false
+		}
+	}
+
 	if len(r.Rules) != len(o.Rules) {
 		return false
 	}
@@ -270,6 +309,14 @@ func (r *ReviewpadFile) appendLabels(o *ReviewpadFile) {
 	}
 }
 
+func (r *ReviewpadFile) appendFunctions(o *ReviewpadFile) {
+	if r.Functions == nil {
+		r.Functions = make([]PadFunction, 0)
+	}
+
+	r.Functions = append(r.Functions, o.Functions...)
+}
+
 func (r *ReviewpadFile) appendRules(o *ReviewpadFile) {
 	if r.Rules == nil {
 		r.Rules = make([]PadRule, 0)
@@ -19,6 +19,12 @@ var mockedReviewpadFile = &ReviewpadFile{
 	Imports: []PadImport{
 		{Url: "https://foo.bar/draft-rule.yml"},
 	},
+	Functions: []PadFunction{
+		{
+			Description: "Checks if the pull request touches the given files",
+			Spec:        "touches(path: String): Bool = $hasFilePattern($path)",
+		},
+	},
 	Groups: []PadGroup{
 		{
 			Name:        "seniors",
@@ -164,6 +170,42 @@ func TestEquals_WhenPadRulesHaveDiffSpec(t *testing.T) {
 	assert.False(t, padRule.equals(otherPadRule))
 }
 
+func TestEquals_WhenPadFunctionsAreEqual(t *testing.T) {
+	function := PadFunction{
+		Description: "Checks if the pull request touches the given files",
+		Spec:        "touches(path: String): Bool = $hasFilePattern($path)",
+	}
+	otherFunction := function
+
+	assert.True(t, function.equals(otherFunction))
+}
+
+func TestEquals_WhenPadFunctionsHaveDiffDescription(t *testing.T) {
+	function := PadFunction{
+		Description: "Checks if the pull request touches the given files",
+		Spec:        "touches(path: String): Bool = $hasFilePattern($path)",
+	}
+	otherFunction := PadFunction{
+		Description: "Checks if the pull request changes the given files",
+		Spec:        "touches(path: String): Bool = $hasFilePattern($path)",
+	}
+
+	assert.False(t, function.equals(otherFunction))
+}
+
+func TestEquals_WhenPadFunctionsHaveDiffSpec(t *testing.T) {
+	function := PadFunction{
+		Description: "Checks if the pull request touches the given files",
+		Spec:        "touches(path: String): Bool = $hasFilePattern($path)",
+	}
+	otherFunction := PadFunction{
+		Description: "Checks if the pull request touches the given files",
+		Spec:        "touches(path: String): Bool = $hasFileName($path)",
+	}
+
+	assert.False(t, function.equals(otherFunction))
+}
+
 func TestEquals_WhenPadWorkflowRulesAreEqual(t *testing.T) {
 	padWorkflowRule := PadWorkflowRule{
 		Rule: "test-rule",
@@ -783,6 +825,28 @@ func TestEquals_WhenReviewpadFilesHaveDiffImports(t *testing.T) {
 	assert.False(t, mockedReviewpadFile.equals(otherReviewpadFile))
 }
 
+func TestEquals_WhenReviewpadFilesHaveDiffNumberOfFunctions(t *testing.T) {
+	otherReviewpadFile := &ReviewpadFile{}
+	copier.Copy(otherReviewpadFile, mockedReviewpadFile)
+
+	otherReviewpadFile.Functions = []PadFunction{}
+
+	assert.False(t, mockedReviewpadFile.equals(otherReviewpadFile))
+}
+
+func TestEquals_WhenReviewpadFilesHaveDiffFunctions(t *testing.T) {
+	otherReviewpadFile := &ReviewpadFile{}
+	copier.Copy(otherReviewpadFile, mockedReviewpadFile)
+
+	otherReviewpadFile.Functions = []PadFunction{
+		{
+			Spec: "isBig(): Bool = $size() > 100",
+		},
+	}
+
+	assert.False(t, mockedReviewpadFile.equals(otherReviewpadFile))
+}
+
 func TestEquals_WhenReviewpadFilesHaveDiffNumberOfRules(t *testing.T) {
 	otherReviewpadFile := &ReviewpadFile{}
 	copier.Copy(otherReviewpadFile, mockedReviewpadFile)
@@ -1009,6 +1073,49 @@ func TestAppendLabels_WhenReviewpadFileHasLabels(t *testing.T) {
 	assert.Equal(t, wantLabels, otherReviewpadFile.Labels)
 }
 
+func TestAppendFunctions_WhenReviewpadFileHasNoFunctions(t *testing.T) {
+	otherReviewpadFile := &ReviewpadFile{}
+	copier.Copy(otherReviewpadFile, mockedReviewpadFile)
+
+	otherReviewpadFile.Functions = nil
+
+	otherReviewpadFile.appendFunctions(mockedReviewpadFile)
+
+	wantFunctions := []PadFunction{
+		{
+			Description: "Checks if the pull request touches the given files",
+			Spec:        "touches(path: String): Bool = $hasFilePattern($path)",
+		},
+	}
+
+	assert.Equal(t, wantFunctions, otherReviewpadFile.Functions)
+}
+
+func TestAppendFunctions_WhenReviewpadFileHasFunctions(t *testing.T) {
+	otherReviewpadFile := &ReviewpadFile{}
+	copier.Copy(otherReviewpadFile, mockedReviewpadFile)
+
+	otherReviewpadFile.Functions = []PadFunction{
+		{
+			Spec: "isBig(): Bool = $size() > 100",
+		},
+	}
+
+	otherReviewpadFile.appendFunctions(mockedReviewpadFile)
+
+	wantFunctions := []PadFunction{
+		{
+			Spec: "isBig(): Bool = $size() > 100",
+		},
+		{
+			Description: "Checks if the pull request touches the given files",
+			Spec:        "touches(path: String): Bool = $hasFilePattern($path)",
+		},
+	}
+
+	assert.Equal(t, wantFunctions, otherReviewpadFile.Functions)
+}
+
 func TestAppendRules_WhenReviewpadFileHasNoRules(t *testing.T) {
 	otherReviewpadFile := &ReviewpadFile{}
 	copier.Copy(otherReviewpadFile, mockedReviewpadFile)
@@ -234,7 +234,60 @@ func lintGroupsMentions(groups []PadGroup, rules []PadRule, workflows []PadWorkf
 	return nil
 }
 
-func Lint(file *ReviewpadFile) error {
+// Validations
+// - Every function is well typed
+// - Every group spec is a well typed group
+// - Every rule spec is a well typed condition
+// - Every workflow action is a well typed action
+func lintTypes(typeChecker TypeChecker, functions []PadFunction, groups []PadGroup, rules []PadRule, workflows []PadWorkflow) error {
+	for _, function := range functions {
+		err := typeChecker.TypeCheckFunction(function, function.specLine)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, group := range groups {
+		line := group.specLine
+		if GroupType(group.Type) == GroupTypeFilter {
+			line = group.whereLine
+		}
+
+		err := typeChecker.TypeCheckGroup(group, line)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, rule := range rules {
+		err := typeChecker.TypeCheckRule(rule, rule.specLine)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, workflow := range workflows {
+		for i, action := range workflow.Actions {
+			err := typeChecker.TypeCheckAction(workflow.Name, action, lineAt(workflow.actionsLines, i))
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, rule := range workflow.Rules {
+			for i, extraAction := range rule.ExtraActions {
+				err := typeChecker.TypeCheckAction(workflow.Name, extraAction, lineAt(rule.extraActionsLines, i))
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func Lint(file *ReviewpadFile, typeChecker TypeChecker) error {
 	err := lintGroups(file.Groups)
 	if err != nil {
 		return err
@@ -255,5 +308,17 @@ func Lint(file *ReviewpadFile) error {
 		return err
 	}
 
-	return lintGroupsMentions(file.Groups, file.Rules, file.Workflows)
+	err = lintGroupsMentions(file.Groups, file.Rules, file.Workflows)
+	if err != nil {
+		return err
+	}
+
+	err = lintTypes(typeChecker, file.Functions, file.Groups, file.Rules, file.Workflows)
+	if err != nil {
+		return err
+	}
+
+	file.compiled = typeChecker.Compiled()
+
+	return nil
 }
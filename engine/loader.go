@@ -30,8 +30,6 @@ func Load(data []byte) (*ReviewpadFile, error) {
 		return nil, err
 	}
 
-	transformedFile := transform(file)
-
 	dHash := hash(data)
 
 	visited := make(map[string]bool)
@@ -44,7 +42,7 @@ func Load(data []byte) (*ReviewpadFile, error) {
 		Stack:   stack,
 	}
 
-	return inlineImports(transformedFile, env)
+	return inlineImports(file, env)
 }
 
 func parse(data []byte) (*ReviewpadFile, error) {
@@ -57,49 +55,6 @@ func parse(data []byte) (*ReviewpadFile, error) {
 	return &file, nil
 }
 
-func transform(file *ReviewpadFile) *ReviewpadFile {
-	var transformedWorkflows []PadWorkflow
-	for _, workflow := range file.Workflows {
-		var transformedRules []PadWorkflowRule
-		for _, rule := range workflow.Rules {
-			var transformedExtraActions []string
-			for _, extraAction := range rule.ExtraActions {
-				transformedExtraActions = append(transformedExtraActions, transformActionStr(extraAction))
-			}
-
-			transformedRules = append(transformedRules, PadWorkflowRule{
-				Rule:         rule.Rule,
-				ExtraActions: transformedExtraActions,
-			})
-		}
-
-		var transformedActions []string
-		for _, action := range workflow.Actions {
-			transformedActions = append(transformedActions, transformActionStr(action))
-		}
-
-		transformedWorkflows = append(transformedWorkflows, PadWorkflow{
-			Name:        workflow.Name,
-			Description: workflow.Description,
-			Rules:       transformedRules,
-			Actions:     transformedActions,
-			AlwaysRun:   workflow.AlwaysRun,
-		})
-	}
-
-	return &ReviewpadFile{
-		Version:      file.Version,
-		Edition:      file.Edition,
-		Mode:         file.Mode,
-		IgnoreErrors: file.IgnoreErrors,
-		Imports:      file.Imports,
-		Groups:       file.Groups,
-		Rules:        file.Rules,
-		Labels:       file.Labels,
-		Workflows:    transformedWorkflows,
-	}
-}
-
 func loadImport(reviewpadImport PadImport) (*ReviewpadFile, string, error) {
 	resp, err := http.Get(reviewpadImport.Url)
 	if err != nil {
@@ -118,9 +73,7 @@ func loadImport(reviewpadImport PadImport) (*ReviewpadFile, string, error) {
 		return nil, "", err
 	}
 
-	transformedFile := transform(file)
-
-	return transformedFile, hash(content), nil
+	return file, hash(content), nil
 }
 
 // InlineImports inlines the imports files into the current reviewpad file
@@ -155,8 +108,9 @@ func inlineImports(file *ReviewpadFile, env *LoadEnv) (*ReviewpadFile, error) {
 		// remove from the stack
 		delete(env.Stack, idHash)
 
-		// append labels, rules and workflows
+		// append labels, functions, groups, rules and workflows
 		file.appendLabels(subTreeFile)
+		file.appendFunctions(subTreeFile)
 		file.appendGroups(subTreeFile)
 		file.appendRules(subTreeFile)
 		file.appendWorkflows(subTreeFile)
@@ -0,0 +1,123 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package engine
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+const (
+	PLAN_TEXT_FORMAT string = "text"
+	PLAN_JSON_FORMAT string = "json"
+)
+
+// PlanStep is a statement of the program along with the side effect it would cause.
+type PlanStep struct {
+	Action string `json:"action"`
+	// Effect is the side effect of the action on the pull request (e.g. add label large)
+	Effect   string   `json:"effect"`
+	Workflow string   `json:"workflow"`
+	Rules    []string `json:"rules"`
+	// Error is why the action could not be described, in which case it has no effect
+	Error string `json:"error,omitempty"`
+}
+
+// Plan lists what a program would do to the pull request when executed.
+// It is the outcome of a dry run.
+type Plan struct {
+	Steps []*PlanStep `json:"steps"`
+}
+
+// BuildPlan describes each statement of the program without executing it.
+// The statements that cannot be described are kept in the plan along with their error,
+// so one of them does not hide what the others would do.
+func BuildPlan(program *Program, interpreter Interpreter) *Plan {
+	plan := &Plan{
+		Steps: make([]*PlanStep, 0, len(program.Statements)),
+	}
+
+	for _, statement := range program.Statements {
+		step := &PlanStep{
+			Action:   statement.Code,
+			Workflow: statement.WorkflowName(),
+			Rules:    statement.RuleNames(),
+		}
+
+		effect, err := interpreter.DescribeStatement(statement)
+		if err != nil {
+			step.Error = err.Error()
+		} else {
+			step.Effect = effect
+		}
+
+		plan.Steps = append(plan.Steps, step)
+	}
+
+	return plan
+}
+
+// Errors returns the steps that could not be described.
+func (plan *Plan) Errors() []*PlanStep {
+	errs := make([]*PlanStep, 0)
+	for _, step := range plan.Steps {
+		if step.Error != "" {
+			errs = append(errs, step)
+		}
+	}
+
+	return errs
+}
+
+// Text renders the plan with one step per line followed by the workflow and rules that triggered it.
+func (plan *Plan) Text() string {
+	if len(plan.Steps) == 0 {
+		return "No changes. No workflows were triggered."
+	}
+
+	var sb strings.Builder
+
+	sb.WriteString("Reviewpad will perform the following actions:\n\n")
+
+	for _, step := range plan.Steps {
+		if step.Error != "" {
+			sb.WriteString(fmt.Sprintf("  ! error: %v\n", step.Error))
+		} else {
+			sb.WriteString(fmt.Sprintf("  + %v\n", step.Effect))
+		}
+		sb.WriteString(fmt.Sprintf("      %v (workflow %v, rules %v)\n", step.Action, step.Workflow, strings.Join(step.Rules, ", ")))
+	}
+
+	sb.WriteString(fmt.Sprintf("\nPlan: %v actions.", len(plan.Steps)))
+
+	if errs := plan.Errors(); len(errs) > 0 {
+		sb.WriteString(fmt.Sprintf(" %v actions could not be described.", len(errs)))
+	}
+
+	return sb.String()
+}
+
+// JSON renders the plan as an indented JSON document.
+func (plan *Plan) JSON() (string, error) {
+	data, err := json.MarshalIndent(plan, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(data), nil
+}
+
+// Render renders the plan in the given format (text or json).
+func (plan *Plan) Render(format string) (string, error) {
+	switch format {
+	case PLAN_TEXT_FORMAT:
+		return plan.Text(), nil
+	case PLAN_JSON_FORMAT:
+		return plan.JSON()
+	}
+
+	return "", execError("unknown plan format %v", format)
+}
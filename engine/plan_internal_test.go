@@ -0,0 +1,146 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var mockedPlan = &Plan{
+	Steps: []*PlanStep{
+		{
+			Action:   `$addLabel("large")`,
+			Effect:   "add label large",
+			Workflow: "label-size",
+			Rules:    []string{"is-large"},
+		},
+		{
+			Action:   `$merge("rebase")`,
+			Effect:   "merge with rebase",
+			Workflow: "ship",
+			Rules:    []string{"is-small", "is-approved"},
+		},
+	},
+}
+
+func TestPlan_Text(t *testing.T) {
+	wantText := `Reviewpad will perform the following actions:
+
+  + add label large
+      $addLabel("large") (workflow label-size, rules is-large)
+  + merge with rebase
+      $merge("rebase") (workflow ship, rules is-small, is-approved)
+
+Plan: 2 actions.`
+
+	assert.Equal(t, wantText, mockedPlan.Text())
+}
+
+func TestPlan_Text_WhenNoSteps(t *testing.T) {
+	plan := &Plan{Steps: []*PlanStep{}}
+
+	assert.Equal(t, "No changes. No workflows were triggered.", plan.Text())
+}
+
+func TestPlan_Text_WhenStepHasError(t *testing.T) {
+	plan := &Plan{
+		Steps: []*PlanStep{
+			{
+				Action:   "$unknown()",
+				Workflow: "label-size",
+				Rules:    []string{"is-large"},
+				Error:    "no type for built-in unknown",
+			},
+			mockedPlan.Steps[0],
+		},
+	}
+
+	wantText := `Reviewpad will perform the following actions:
+
+  ! error: no type for built-in unknown
+      $unknown() (workflow label-size, rules is-large)
+  + add label large
+      $addLabel("large") (workflow label-size, rules is-large)
+
+Plan: 2 actions. 1 actions could not be described.`
+
+	assert.Equal(t, wantText, plan.Text())
+}
+
+func TestPlan_JSON(t *testing.T) {
+	wantJSON := `{
+  "steps": [
+    {
+      "action": "$addLabel(\"large\")",
+      "effect": "add label large",
+      "workflow": "label-size",
+      "rules": [
+        "is-large"
+      ]
+    },
+    {
+      "action": "$merge(\"rebase\")",
+      "effect": "merge with rebase",
+      "workflow": "ship",
+      "rules": [
+        "is-small",
+        "is-approved"
+      ]
+    }
+  ]
+}`
+
+	gotJSON, err := mockedPlan.JSON()
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantJSON, gotJSON)
+}
+
+func TestPlan_Render(t *testing.T) {
+	gotText, err := mockedPlan.Render(PLAN_TEXT_FORMAT)
+
+	assert.Nil(t, err)
+	assert.Equal(t, mockedPlan.Text(), gotText)
+}
+
+func TestPlan_Render_WhenFormatIsUnknown(t *testing.T) {
+	_, err := mockedPlan.Render("yaml")
+
+	assert.EqualError(t, err, "[reviewpad] unknown plan format yaml")
+}
+
+func TestPlan_JSON_WhenStepHasError(t *testing.T) {
+	plan := &Plan{
+		Steps: []*PlanStep{
+			{
+				Action:   "$unknown()",
+				Workflow: "label-size",
+				Rules:    []string{"is-large"},
+				Error:    "no type for built-in unknown",
+			},
+		},
+	}
+
+	wantJSON := `{
+  "steps": [
+    {
+      "action": "$unknown()",
+      "effect": "",
+      "workflow": "label-size",
+      "rules": [
+        "is-large"
+      ],
+      "error": "no type for built-in unknown"
+    }
+  ]
+}`
+
+	gotJSON, err := plan.JSON()
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantJSON, gotJSON)
+}
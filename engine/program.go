@@ -4,6 +4,12 @@
 
 package engine
 
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
 type Metadata struct {
 	Workflow    PadWorkflow
 	TriggeredBy []PadWorkflowRule
@@ -16,6 +22,9 @@ type Statement struct {
 
 type Program struct {
 	Statements []*Statement
+	// IgnoreErrors keeps executing the statements after one of them fails (see ReviewpadFile.IgnoreErrors).
+	// The actions that stop the program (e.g. $fail) stop it even when the errors are ignored.
+	IgnoreErrors bool
 }
 
 func (program *Program) append(workflowActions []string, workflow PadWorkflow, workflowRules []PadWorkflowRule) {
@@ -31,3 +40,83 @@ func (program *Program) append(workflowActions []string, workflow PadWorkflow, w
 		program.Statements = append(program.Statements, statement)
 	}
 }
+
+// RuleNames returns the names of the rules that triggered the statement.
+func (statement *Statement) RuleNames() []string {
+	if statement.Metadata == nil {
+		return []string{}
+	}
+
+	ruleNames := make([]string, len(statement.Metadata.TriggeredBy))
+	for i, rule := range statement.Metadata.TriggeredBy {
+		ruleNames[i] = rule.Rule
+	}
+
+	return ruleNames
+}
+
+// WorkflowName returns the name of the workflow of the statement ("" when unknown).
+func (statement *Statement) WorkflowName() string {
+	if statement.Metadata == nil {
+		return ""
+	}
+
+	return statement.Metadata.Workflow.Name
+}
+
+// StatementError is the error of a statement that failed to execute.
+type StatementError struct {
+	Statement *Statement
+	Err       error
+}
+
+func (e *StatementError) Error() string {
+	return fmt.Sprintf(
+		"%v (workflow %v, rules %v): %v",
+		e.Statement.Code,
+		e.Statement.WorkflowName(),
+		strings.Join(e.Statement.RuleNames(), ", "),
+		e.Err,
+	)
+}
+
+func (e *StatementError) Unwrap() error {
+	return e.Err
+}
+
+// ExecErrors are the errors of all the statements that failed when the errors are ignored,
+// ending with the error that stopped the program if any (e.g. $fail).
+type ExecErrors []*StatementError
+
+func (errs ExecErrors) Error() string {
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("%v statements failed:", len(errs)))
+	for _, err := range errs {
+		sb.WriteString(fmt.Sprintf("\n\t%v", err))
+	}
+
+	return sb.String()
+}
+
+// Is tells if the error of any of the statements matches target (see errors.Is).
+func (errs ExecErrors) Is(target error) bool {
+	for _, err := range errs {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// As finds the first error of the statements that matches target (see errors.As).
+func (errs ExecErrors) As(target interface{}) bool {
+	for _, err := range errs {
+		if errors.As(err, target) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -5,6 +5,7 @@
 package engine
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -55,3 +56,46 @@ func TestAppend(t *testing.T) {
 
 	assert.Equal(t, wantProgram, programUnderTest)
 }
+
+func TestExecErrors(t *testing.T) {
+	statement := &Statement{
+		Code: `$merge("rebase")`,
+		Metadata: &Metadata{
+			Workflow: PadWorkflow{
+				Name: "ship",
+			},
+			TriggeredBy: []PadWorkflowRule{
+				{Rule: "is-small"},
+				{Rule: "is-approved"},
+			},
+		},
+	}
+
+	errs := ExecErrors{
+		{Statement: statement, Err: errors.New("merge conflict")},
+		{Statement: &Statement{Code: "$close()"}, Err: errors.New("not found")},
+	}
+
+	wantErr := "2 statements failed:\n" +
+		"\t$merge(\"rebase\") (workflow ship, rules is-small, is-approved): merge conflict\n" +
+		"\t$close() (workflow , rules ): not found"
+
+	assert.EqualError(t, errs, wantErr)
+}
+
+func TestStatementError_Unwrap(t *testing.T) {
+	cause := errors.New("merge conflict")
+	err := &StatementError{Statement: &Statement{Code: "$merge()"}, Err: cause}
+
+	assert.True(t, errors.Is(err, cause))
+}
+
+func TestExecErrors_Is(t *testing.T) {
+	cause := errors.New("not found")
+	errs := ExecErrors{
+		{Statement: &Statement{Code: "$merge()"}, Err: errors.New("merge conflict")},
+		{Statement: &Statement{Code: "$close()"}, Err: cause},
+	}
+
+	assert.True(t, errors.Is(errs, cause))
+}
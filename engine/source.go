@@ -0,0 +1,130 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package engine
+
+import "gopkg.in/yaml.v3"
+
+// The reviewpad file types keep track of the lines where their specs and actions
+// are declared so that errors can point to the exact location in the file.
+
+// valueLine returns the line where the content of a YAML value starts.
+// Block scalars (| and >) start on the line after the indicator.
+func valueLine(node *yaml.Node) int {
+	if node.Style&(yaml.LiteralStyle|yaml.FoldedStyle) != 0 {
+		return node.Line + 1
+	}
+
+	return node.Line
+}
+
+// mappingValue returns the value of key in a YAML mapping (nil when absent).
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+
+	return nil
+}
+
+// mappingValueLine returns the line of the value of key in a YAML mapping (0 when absent).
+func mappingValueLine(node *yaml.Node, key string) int {
+	value := mappingValue(node, key)
+	if value == nil {
+		return 0
+	}
+
+	return valueLine(value)
+}
+
+// sequenceLines returns the line of each element of the value of key in a YAML mapping.
+func sequenceLines(node *yaml.Node, key string) []int {
+	value := mappingValue(node, key)
+	if value == nil {
+		return nil
+	}
+
+	lines := make([]int, 0)
+	for _, elem := range value.Content {
+		lines = append(lines, valueLine(elem))
+	}
+
+	return lines
+}
+
+// lineAt returns the line at position i (0 when unknown).
+func lineAt(lines []int, i int) int {
+	if i < len(lines) {
+		return lines[i]
+	}
+
+	return 0
+}
+
+func (p *PadFunction) UnmarshalYAML(node *yaml.Node) error {
+	type padFunction PadFunction
+	err := node.Decode((*padFunction)(p))
+	if err != nil {
+		return err
+	}
+
+	p.specLine = mappingValueLine(node, "spec")
+
+	return nil
+}
+
+func (p *PadGroup) UnmarshalYAML(node *yaml.Node) error {
+	type padGroup PadGroup
+	err := node.Decode((*padGroup)(p))
+	if err != nil {
+		return err
+	}
+
+	p.specLine = mappingValueLine(node, "spec")
+	p.whereLine = mappingValueLine(node, "where")
+
+	return nil
+}
+
+func (p *PadRule) UnmarshalYAML(node *yaml.Node) error {
+	type padRule PadRule
+	err := node.Decode((*padRule)(p))
+	if err != nil {
+		return err
+	}
+
+	p.specLine = mappingValueLine(node, "spec")
+
+	return nil
+}
+
+func (p *PadWorkflowRule) UnmarshalYAML(node *yaml.Node) error {
+	type padWorkflowRule PadWorkflowRule
+	err := node.Decode((*padWorkflowRule)(p))
+	if err != nil {
+		return err
+	}
+
+	p.extraActionsLines = sequenceLines(node, "extra-actions")
+
+	return nil
+}
+
+func (p *PadWorkflow) UnmarshalYAML(node *yaml.Node) error {
+	type padWorkflow PadWorkflow
+	err := node.Decode((*padWorkflow)(p))
+	if err != nil {
+		return err
+	}
+
+	p.actionsLines = sequenceLines(node, "then")
+
+	return nil
+}
@@ -0,0 +1,60 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var mockedReviewpadFileWithLines = `
+functions:
+  - spec: 'touches(path: String): Bool = $hasFilePattern($path)'
+
+groups:
+  - name: seniors
+    spec: '["john"]'
+  - name: juniors
+    type: filter
+    param: dev
+    where: |
+      $totalCreatedPullRequests($dev) < 10
+
+rules:
+  - name: is-small
+    kind: patch
+    spec: $size() <= 30
+
+workflows:
+  - name: add-label-with-size
+    if:
+      - rule: is-small
+        extra-actions:
+          - '$addLabel("small")'
+    then:
+      - '$addLabel("ship")'
+      - '$merge("rebase")'
+`
+
+func TestParse_RecordsLines(t *testing.T) {
+	file, err := parse([]byte(mockedReviewpadFileWithLines))
+	if err != nil {
+		assert.FailNow(t, "parse failed: %v", err)
+	}
+
+	assert.Equal(t, 3, file.Functions[0].specLine)
+	assert.Equal(t, 7, file.Groups[0].specLine)
+	assert.Equal(t, 0, file.Groups[0].whereLine)
+	assert.Equal(t, 12, file.Groups[1].whereLine)
+	assert.Equal(t, 17, file.Rules[0].specLine)
+	assert.Equal(t, []int{24}, file.Workflows[0].Rules[0].extraActionsLines)
+	assert.Equal(t, []int{26, 27}, file.Workflows[0].actionsLines)
+}
+
+func TestLineAt(t *testing.T) {
+	assert.Equal(t, 3, lineAt([]int{2, 3}, 1))
+	assert.Equal(t, 0, lineAt([]int{2, 3}, 2))
+}
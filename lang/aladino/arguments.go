@@ -0,0 +1,202 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package aladino
+
+import (
+	"errors"
+	"fmt"
+)
+
+// errTooManyArguments is returned when a call has more positional arguments than the function has parameters.
+var errTooManyArguments = errors.New("too many arguments")
+
+// matchArguments matches the arguments of a call to the parameters of the function.
+// The i-th element of the result is the position in fc.arguments of the argument of the i-th parameter,
+// or -1 when the parameter has no argument.
+// Positional arguments match the parameters in order and must come before the named arguments.
+func matchArguments(fc *FunctionCall, fnType *FunctionType) ([]int, error) {
+	argIndexes := make([]int, len(fnType.paramTypes))
+	for i := range argIndexes {
+		argIndexes[i] = -1
+	}
+
+	hasNamedArgs := false
+	for i, arg := range fc.arguments {
+		namedArg, ok := arg.(*NamedArg)
+		if !ok {
+			if hasNamedArgs {
+				return nil, &TypeError{
+					Expr:    arg,
+					Message: fmt.Sprintf("positional argument after named argument in call to %v", fc.name.ident),
+				}
+			}
+
+			if i >= len(argIndexes) {
+				return nil, errTooManyArguments
+			}
+
+			argIndexes[i] = i
+			continue
+		}
+
+		hasNamedArgs = true
+
+		paramIndex := fnType.paramIndex(namedArg.name)
+		if paramIndex == -1 {
+			return nil, &TypeError{
+				Expr:    arg,
+				Message: fmt.Sprintf("unknown parameter %v of %v", namedArg.name, fc.name.ident),
+			}
+		}
+
+		if argIndexes[paramIndex] != -1 {
+			return nil, &TypeError{
+				Expr:    arg,
+				Message: fmt.Sprintf("duplicate argument for parameter %v of %v", namedArg.name, fc.name.ident),
+			}
+		}
+
+		argIndexes[paramIndex] = i
+	}
+
+	return argIndexes, nil
+}
+
+// bindArguments returns the values of the parameters of a built-in with the type fnType
+// from the values of the arguments of a call, where the optional parameters without
+// argument take their default value.
+// Pre-condition: the call is well typed
+func bindArguments(fc *FunctionCall, fnType Type, args []Value) ([]Value, error) {
+	ty, ok := fnType.(*FunctionType)
+	if !ok {
+		return args, nil
+	}
+
+	// The arguments of the variadic parameter are passed one after the other
+	ty = ty.expand(len(args))
+
+	argIndexes, err := matchArguments(fc, ty)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]Value, len(argIndexes))
+	for i, argIndex := range argIndexes {
+		if argIndex == -1 {
+			values[i] = ty.defaultValue(i)
+			continue
+		}
+
+		// JSON arguments are only known to have the type of the parameter at this point
+		value, ok := conformValue(args[argIndex], ty.paramTypes[i])
+		if !ok {
+			return nil, fmt.Errorf("eval: argument %v of %v is not of type %v", argIndex+1, fc.name.ident, formatType(ty.paramTypes[i]))
+		}
+
+		values[i] = value
+	}
+
+	return values, nil
+}
+
+// valueKinds are the kinds of the values of the types that have no type parameters.
+var valueKinds = map[string]string{
+	BOOL_TYPE:     BOOL_VALUE,
+	INT_TYPE:      INT_VALUE,
+	FLOAT_TYPE:    FLOAT_VALUE,
+	STRING_TYPE:   STRING_VALUE,
+	TIME_TYPE:     TIME_VALUE,
+	DURATION_TYPE: DURATION_VALUE,
+	FUNCTION_TYPE: FUNCTION_VALUE,
+}
+
+// conformValue checks if a value has the type ty, in which case it returns the value
+// with its ints promoted to floats where ty has floats (e.g. 3 in a JSON array for a []Float parameter).
+func conformValue(value Value, ty Type) (Value, bool) {
+	switch ty := ty.(type) {
+	case nil, *TypeVar, *JSONType:
+		return value, true
+	case *FloatType:
+		if intValue, ok := value.(*IntValue); ok {
+			return BuildFloatValue(float64(intValue.Val)), true
+		}
+	case *ArrayOfType, *ArrayType:
+		arrayValue, ok := value.(*ArrayValue)
+		if !ok {
+			return nil, false
+		}
+
+		var vals []Value
+		for i, elem := range arrayValue.Vals {
+			conformedElem, ok := conformValue(elem, arrayElemTypeAt(ty, i))
+			if !ok {
+				return nil, false
+			}
+
+			// The array is only copied when one of its elements is promoted
+			if conformedElem != elem && vals == nil {
+				vals = make([]Value, len(arrayValue.Vals))
+				copy(vals, arrayValue.Vals)
+			}
+
+			if vals != nil {
+				vals[i] = conformedElem
+			}
+		}
+
+		if vals == nil {
+			return value, true
+		}
+
+		return BuildArrayValue(vals), true
+	case *MapType:
+		mapValue, ok := value.(*MapValue)
+		if !ok {
+			return nil, false
+		}
+
+		var vals map[string]Value
+		for key, elem := range mapValue.Vals {
+			conformedElem, ok := conformValue(elem, ty.valueType)
+			if !ok {
+				return nil, false
+			}
+
+			// The map is only copied when one of its values is promoted
+			if conformedElem != elem && vals == nil {
+				vals = make(map[string]Value, len(mapValue.Vals))
+				for key, elem := range mapValue.Vals {
+					vals[key] = elem
+				}
+			}
+
+			if vals != nil {
+				vals[key] = conformedElem
+			}
+		}
+
+		if vals == nil {
+			return value, true
+		}
+
+		return BuildMapValue(vals), true
+	}
+
+	return value, value.HasKindOf(valueKinds[ty.Kind()])
+}
+
+// arrayElemTypeAt returns the type of the i-th element of an array type (nil when unknown).
+func arrayElemTypeAt(ty Type, i int) Type {
+	switch arrayTy := ty.(type) {
+	case *ArrayOfType:
+		return arrayTy.elemType
+	case *ArrayType:
+		if i < len(arrayTy.elemsType) {
+			return arrayTy.elemsType[i]
+		}
+	}
+
+	return nil
+}
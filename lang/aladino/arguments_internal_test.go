@@ -0,0 +1,234 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package aladino
+
+import (
+	"log"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// repeatType is the type of a built-in with the signature repeat(str: String, times: Int = 2) => String
+var repeatType = BuildFunctionTypeWithParams(
+	[]*Param{
+		BuildParam("str", BuildStringType()),
+		BuildOptionalParam("times", BuildIntType(), BuildIntValue(2)),
+	},
+	BuildStringType(),
+)
+
+func mockRepeatBuiltIns() *BuiltIns {
+	return &BuiltIns{
+		Functions: map[string]*BuiltInFunction{
+			"repeat": {
+				Type: repeatType,
+				Code: func(e Env, args []Value) (Value, error) {
+					str := args[0].(*StringValue).Val
+					times := args[1].(*IntValue).Val
+					return BuildStringValue(strings.Repeat(str, times)), nil
+				},
+			},
+		},
+		Actions: map[string]*BuiltInAction{},
+	}
+}
+
+func TestMatchArguments_WhenPositionalArguments(t *testing.T) {
+	fc := BuildFunctionCall(BuildVariable("repeat"), []Expr{BuildStringConst("a"), BuildIntConst(3)})
+
+	gotIndexes, err := matchArguments(fc, repeatType)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []int{0, 1}, gotIndexes)
+}
+
+func TestMatchArguments_WhenNamedArguments(t *testing.T) {
+	fc := BuildFunctionCall(
+		BuildVariable("repeat"),
+		[]Expr{BuildNamedArg("times", BuildIntConst(3)), BuildNamedArg("str", BuildStringConst("a"))},
+	)
+
+	gotIndexes, err := matchArguments(fc, repeatType)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []int{1, 0}, gotIndexes)
+}
+
+func TestMatchArguments_WhenOptionalArgumentIsMissing(t *testing.T) {
+	fc := BuildFunctionCall(BuildVariable("repeat"), []Expr{BuildStringConst("a")})
+
+	gotIndexes, err := matchArguments(fc, repeatType)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []int{0, -1}, gotIndexes)
+}
+
+func TestMatchArguments_WhenTooManyArguments(t *testing.T) {
+	fc := BuildFunctionCall(
+		BuildVariable("repeat"),
+		[]Expr{BuildStringConst("a"), BuildIntConst(3), BuildIntConst(4)},
+	)
+
+	gotIndexes, err := matchArguments(fc, repeatType)
+
+	assert.Nil(t, gotIndexes)
+	assert.Equal(t, errTooManyArguments, err)
+}
+
+func TestMatchArguments_WhenParameterIsUnknown(t *testing.T) {
+	arg := BuildNamedArg("count", BuildIntConst(3))
+	fc := BuildFunctionCall(BuildVariable("repeat"), []Expr{BuildStringConst("a"), arg})
+
+	gotIndexes, err := matchArguments(fc, repeatType)
+
+	wantErr := &TypeError{
+		Expr:    arg,
+		Message: "unknown parameter count of repeat",
+	}
+
+	assert.Nil(t, gotIndexes)
+	assert.Equal(t, wantErr, err)
+}
+
+func TestMatchArguments_WhenArgumentIsDuplicated(t *testing.T) {
+	arg := BuildNamedArg("str", BuildStringConst("b"))
+	fc := BuildFunctionCall(BuildVariable("repeat"), []Expr{BuildStringConst("a"), arg})
+
+	gotIndexes, err := matchArguments(fc, repeatType)
+
+	wantErr := &TypeError{
+		Expr:    arg,
+		Message: "duplicate argument for parameter str of repeat",
+	}
+
+	assert.Nil(t, gotIndexes)
+	assert.Equal(t, wantErr, err)
+}
+
+func TestMatchArguments_WhenPositionalArgumentAfterNamedArgument(t *testing.T) {
+	arg := BuildIntConst(3)
+	fc := BuildFunctionCall(BuildVariable("repeat"), []Expr{BuildNamedArg("str", BuildStringConst("a")), arg})
+
+	gotIndexes, err := matchArguments(fc, repeatType)
+
+	wantErr := &TypeError{
+		Expr:    arg,
+		Message: "positional argument after named argument in call to repeat",
+	}
+
+	assert.Nil(t, gotIndexes)
+	assert.Equal(t, wantErr, err)
+}
+
+func TestTypeInfer_WhenFunctionCallHasNamedArguments(t *testing.T) {
+	typeEnv := MockTypeEnv().extend(TypeEnv{"repeat": repeatType})
+
+	fc := BuildFunctionCall(
+		BuildVariable("repeat"),
+		[]Expr{BuildStringConst("a"), BuildNamedArg("times", BuildIntConst(3))},
+	)
+	gotType, err := fc.typeinfer(typeEnv)
+
+	assert.Nil(t, err)
+	assert.Equal(t, BuildStringType(), gotType)
+}
+
+func TestTypeInfer_WhenNamedArgumentHasWrongType(t *testing.T) {
+	typeEnv := MockTypeEnv().extend(TypeEnv{"repeat": repeatType})
+
+	arg := BuildNamedArg("times", BuildStringConst("3"))
+	fc := BuildFunctionCall(BuildVariable("repeat"), []Expr{BuildStringConst("a"), arg})
+	gotType, err := fc.typeinfer(typeEnv)
+
+	wantErr := &TypeError{
+		Expr:     arg,
+		Expected: BuildIntType(),
+		Actual:   BuildStringType(),
+		Message:  "type inference failed: mismatch in arg types on repeat",
+	}
+
+	assert.Nil(t, gotType)
+	assert.Equal(t, wantErr, err)
+}
+
+func TestTypeInfer_WhenRequiredArgumentIsMissing(t *testing.T) {
+	typeEnv := MockTypeEnv().extend(TypeEnv{"repeat": repeatType})
+
+	fc := BuildFunctionCall(BuildVariable("repeat"), []Expr{BuildNamedArg("times", BuildIntConst(3))})
+	gotType, err := fc.typeinfer(typeEnv)
+
+	assert.Nil(t, gotType)
+	assert.EqualError(t, err, "type inference failed: mismatch in arg types on repeat")
+}
+
+func TestEval_WhenOptionalArgumentIsMissing(t *testing.T) {
+	mockedEnv, err := MockDefaultEnvWithBuiltIns(nil, nil, mockRepeatBuiltIns())
+	if err != nil {
+		log.Fatalf("mockDefaultEnvWithBuiltIns failed: %v", err)
+	}
+
+	fc := BuildFunctionCall(BuildVariable("repeat"), []Expr{BuildStringConst("ab")})
+	gotVal, err := fc.Eval(mockedEnv)
+
+	assert.Nil(t, err)
+	assert.Equal(t, BuildStringValue("abab"), gotVal)
+}
+
+func TestEval_WhenNamedArguments(t *testing.T) {
+	mockedEnv, err := MockDefaultEnvWithBuiltIns(nil, nil, mockRepeatBuiltIns())
+	if err != nil {
+		log.Fatalf("mockDefaultEnvWithBuiltIns failed: %v", err)
+	}
+
+	expr, err := Parse(`$repeat(times: 3, str: "ab")`)
+	if err != nil {
+		log.Fatalf("parse failed: %v", err)
+	}
+
+	gotVal, err := expr.Eval(mockedEnv)
+
+	assert.Nil(t, err)
+	assert.Equal(t, BuildStringValue("ababab"), gotVal)
+}
+
+func TestBindArguments_WhenVariadicFunction(t *testing.T) {
+	fnType := BuildVariadicFunctionType([]Type{BuildIntType(), BuildStringType()}, nil)
+	fc := BuildFunctionCall(
+		BuildVariable("f"),
+		[]Expr{BuildIntConst(1), BuildStringConst("a"), BuildStringConst("b")},
+	)
+	args := []Value{BuildIntValue(1), BuildStringValue("a"), BuildStringValue("b")}
+
+	gotArgs, err := bindArguments(fc, fnType, args)
+
+	assert.Nil(t, err)
+	assert.Equal(t, args, gotArgs)
+}
+
+func TestBindArguments_WhenIntIsPassedAsFloat(t *testing.T) {
+	fnType := BuildFunctionType([]Type{BuildArrayOfType(BuildFloatType())}, nil)
+	fc := BuildFunctionCall(BuildVariable("f"), []Expr{BuildVariable("json")})
+	args := []Value{BuildArrayValue([]Value{BuildIntValue(1), BuildFloatValue(0.5)})}
+
+	gotArgs, err := bindArguments(fc, fnType, args)
+
+	wantArgs := []Value{BuildArrayValue([]Value{BuildFloatValue(1), BuildFloatValue(0.5)})}
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantArgs, gotArgs)
+}
+
+func TestBindArguments_WhenArgumentDoesNotHaveParameterType(t *testing.T) {
+	fnType := BuildFunctionType([]Type{BuildStringType(), BuildIntType()}, nil)
+	fc := BuildFunctionCall(BuildVariable("f"), []Expr{BuildStringConst("a"), BuildVariable("json")})
+	args := []Value{BuildStringValue("a"), BuildStringValue("3")}
+
+	gotArgs, err := bindArguments(fc, fnType, args)
+
+	assert.Nil(t, gotArgs)
+	assert.EqualError(t, err, "eval: argument 2 of f is not of type Int")
+}
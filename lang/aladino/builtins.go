@@ -12,11 +12,17 @@ type BuiltIns struct {
 type BuiltInFunction struct {
 	Type Type
 	Code func(e Env, args []Value) (Value, error)
+	// Pure built-ins return the same value for the same arguments during a run,
+	// so their results are cached in the memo of the environment.
+	Pure bool
 }
 
 type BuiltInAction struct {
 	Type Type
 	Code func(e Env, args []Value) error
+	// Describe returns the side effect of the action for the given arguments (e.g. add label large).
+	// It is used to show the plan of a dry run and must not change anything.
+	Describe func(e Env, args []Value) string
 }
 
 func MergeAladinoBuiltIns(builtInsList ...*BuiltIns) *BuiltIns {
@@ -37,3 +43,13 @@ func MergeAladinoBuiltIns(builtInsList ...*BuiltIns) *BuiltIns {
 
 	return mergedBuiltIns
 }
+
+// ownBuiltIns copies the built-ins so that the user-defined functions declared
+// by a type checker or an interpreter do not leak into the built-ins of the caller.
+func ownBuiltIns(builtIns *BuiltIns) *BuiltIns {
+	if builtIns == nil {
+		return nil
+	}
+
+	return MergeAladinoBuiltIns(builtIns)
+}
@@ -0,0 +1,37 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package aladino
+
+import "time"
+
+// Clock tells the current time to relative timestamps (e.g. 3 days ago) and to built-ins such as $now().
+// Evaluating the same expression with the same clock always yields the same result.
+type Clock interface {
+	Now() time.Time
+}
+
+type systemClock struct{}
+
+func (c *systemClock) Now() time.Time {
+	return time.Now()
+}
+
+// NewSystemClock returns the clock that tells the current time of the system.
+func NewSystemClock() Clock {
+	return &systemClock{}
+}
+
+type fixedClock struct {
+	now time.Time
+}
+
+func (c *fixedClock) Now() time.Time {
+	return c.now
+}
+
+// NewFixedClock returns a clock that is stopped at now.
+func NewFixedClock(now time.Time) Clock {
+	return &fixedClock{now}
+}
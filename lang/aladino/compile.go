@@ -0,0 +1,96 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package aladino
+
+import "github.com/reviewpad/reviewpad/v3/engine"
+
+// Compiled holds the type checked ASTs of the functions, groups, rules and actions of a reviewpad file by source.
+// It is built once by the type checker when the file is linted and it is only read afterwards,
+// so every run of the file reuses the ASTs without parsing nor type checking them again.
+type Compiled struct {
+	functions  map[string]*FunctionDef
+	groups     map[groupSource]Expr
+	conditions map[string]Expr
+	actions    map[string]ExecExpr
+}
+
+// groupSource identifies a group by the specs that define it.
+type groupSource struct {
+	typeOf    engine.GroupType
+	expr      string
+	paramExpr string
+	whereExpr string
+}
+
+func newCompiled() *Compiled {
+	return &Compiled{
+		functions:  make(map[string]*FunctionDef),
+		groups:     make(map[groupSource]Expr),
+		conditions: make(map[string]Expr),
+		actions:    make(map[string]ExecExpr),
+	}
+}
+
+// The methods below can be called on a nil *Compiled, in which case nothing is compiled.
+
+func (c *Compiled) addFunction(spec string, def *FunctionDef) {
+	if c != nil {
+		c.functions[spec] = def
+	}
+}
+
+func (c *Compiled) addGroup(source groupSource, expr Expr) {
+	if c != nil {
+		c.groups[source] = expr
+	}
+}
+
+func (c *Compiled) addCondition(spec string, expr Expr) {
+	if c != nil {
+		c.conditions[spec] = expr
+	}
+}
+
+func (c *Compiled) addAction(action string, expr ExecExpr) {
+	if c != nil {
+		c.actions[action] = expr
+	}
+}
+
+func (c *Compiled) function(spec string) (*FunctionDef, bool) {
+	if c == nil {
+		return nil, false
+	}
+
+	def, ok := c.functions[spec]
+	return def, ok
+}
+
+func (c *Compiled) group(source groupSource) (Expr, bool) {
+	if c == nil {
+		return nil, false
+	}
+
+	expr, ok := c.groups[source]
+	return expr, ok
+}
+
+func (c *Compiled) condition(spec string) (Expr, bool) {
+	if c == nil {
+		return nil, false
+	}
+
+	expr, ok := c.conditions[spec]
+	return expr, ok
+}
+
+func (c *Compiled) action(action string) (ExecExpr, bool) {
+	if c == nil {
+		return nil, false
+	}
+
+	expr, ok := c.actions[action]
+	return expr, ok
+}
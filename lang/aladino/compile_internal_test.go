@@ -0,0 +1,168 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package aladino
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/reviewpad/reviewpad/v3/engine"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTypeChecker_CompilesTheFile(t *testing.T) {
+	typeChecker := NewTypeChecker(MockBuiltIns())
+
+	function := engine.PadFunction{Spec: "isZero(n: Int): Bool = $n == 0"}
+	group := engine.PadGroup{Name: "seniors", Kind: "developer", Spec: `["john"]`}
+	rule := engine.PadRule{Name: "is-zero", Spec: "$isZero($zeroConst())"}
+	action := "$emptyAction()"
+
+	assert.Nil(t, typeChecker.TypeCheckFunction(function, 1))
+	assert.Nil(t, typeChecker.TypeCheckGroup(group, 2))
+	assert.Nil(t, typeChecker.TypeCheckRule(rule, 3))
+	assert.Nil(t, typeChecker.TypeCheckAction("test", action, 4))
+
+	compiled := typeChecker.Compiled().(*Compiled)
+
+	def, ok := compiled.function(function.Spec)
+	assert.True(t, ok)
+	assert.Equal(t, "isZero", def.Name())
+
+	_, ok = compiled.group(groupSource{expr: group.Spec})
+	assert.True(t, ok)
+
+	wantCondition, err := Parse(rule.Spec)
+	if err != nil {
+		assert.FailNow(t, "parse failed: %v", err)
+	}
+
+	gotCondition, ok := compiled.condition(rule.Spec)
+	assert.True(t, ok)
+	assert.Equal(t, wantCondition, gotCondition)
+
+	_, ok = compiled.action(action)
+	assert.True(t, ok)
+}
+
+func TestTypeChecker_DoesNotCompileIllTypedSpecs(t *testing.T) {
+	typeChecker := NewTypeChecker(MockBuiltIns())
+
+	rule := engine.PadRule{Name: "not-a-condition", Spec: "$zeroConst()"}
+
+	assert.NotNil(t, typeChecker.TypeCheckRule(rule, 1))
+
+	_, ok := typeChecker.Compiled().(*Compiled).condition(rule.Spec)
+
+	assert.False(t, ok)
+}
+
+func TestProcessCompiled_WhenCompiledIsNotAladino(t *testing.T) {
+	mockedInterpreter := &Interpreter{}
+
+	err := mockedInterpreter.ProcessCompiled("$zeroConst() == 0")
+
+	assert.EqualError(t, err, "ProcessCompiled: unexpected compiled file string")
+}
+
+// The compiled specs are never parsed again: the spec below is not even valid Aladino
+func TestEvalExpr_OnInterpreter_WhenConditionIsCompiled(t *testing.T) {
+	mockedEnv, err := MockDefaultEnv(nil, nil)
+	if err != nil {
+		assert.FailNow(t, fmt.Sprintf("MockDefaultEnv failed: %v", err))
+	}
+
+	compiled := newCompiled()
+	compiled.addCondition("is zero", BuildBinaryOp(BuildFunctionCall(BuildVariable("zeroConst"), []Expr{}), eqOperator(), BuildIntConst(0)))
+
+	mockedInterpreter := &Interpreter{
+		Env: mockedEnv,
+	}
+
+	err = mockedInterpreter.ProcessCompiled(compiled)
+	if err != nil {
+		assert.FailNow(t, fmt.Sprintf("ProcessCompiled failed: %v", err))
+	}
+
+	gotVal, err := mockedInterpreter.EvalExpr("patch", "is zero")
+
+	assert.Nil(t, err)
+	assert.True(t, gotVal)
+}
+
+func TestExecStatement_WhenActionIsCompiled(t *testing.T) {
+	mockedEnv, err := MockDefaultEnv(nil, nil)
+	if err != nil {
+		assert.FailNow(t, fmt.Sprintf("MockDefaultEnv failed: %v", err))
+	}
+
+	compiled := newCompiled()
+	compiled.addAction("empty action", BuildFunctionCall(BuildVariable("emptyAction"), []Expr{}))
+
+	mockedInterpreter := &Interpreter{
+		Env:      mockedEnv,
+		compiled: compiled,
+	}
+
+	err = mockedInterpreter.ExecStatement(&engine.Statement{
+		Code:     "empty action",
+		Metadata: &engine.Metadata{Workflow: engine.PadWorkflow{Name: "test"}},
+	})
+
+	assert.Nil(t, err)
+}
+
+func TestProcessFunction_WhenFunctionIsCompiled(t *testing.T) {
+	mockedEnv, err := MockDefaultEnv(nil, nil)
+	if err != nil {
+		assert.FailNow(t, fmt.Sprintf("MockDefaultEnv failed: %v", err))
+	}
+
+	spec := "isZero(n: Int): Bool = $n == 0"
+	def, err := ParseFunctionDef(spec)
+	if err != nil {
+		assert.FailNow(t, fmt.Sprintf("ParseFunctionDef failed: %v", err))
+	}
+
+	compiled := newCompiled()
+	compiled.addFunction(spec, def)
+
+	mockedInterpreter := &Interpreter{
+		Env:      mockedEnv,
+		compiled: compiled,
+	}
+
+	err = mockedInterpreter.ProcessFunction(spec)
+	assert.Nil(t, err)
+
+	gotVal, err := mockedInterpreter.EvalExpr("patch", "$isZero($zeroConst())")
+
+	assert.Nil(t, err)
+	assert.True(t, gotVal)
+}
+
+func TestProcessGroup_WhenGroupIsCompiled(t *testing.T) {
+	mockedEnv, err := MockDefaultEnv(nil, nil)
+	if err != nil {
+		assert.FailNow(t, fmt.Sprintf("MockDefaultEnv failed: %v", err))
+	}
+
+	spec := `["john"]`
+
+	compiled := newCompiled()
+	compiled.addGroup(groupSource{engine.GroupTypeStatic, spec, "", ""}, BuildArray([]Expr{BuildStringConst("jane")}))
+
+	mockedInterpreter := &Interpreter{
+		Env:      mockedEnv,
+		compiled: compiled,
+	}
+
+	err = mockedInterpreter.ProcessGroup("seniors", engine.GroupKindDeveloper, engine.GroupTypeStatic, spec, "", "")
+
+	wantGroup := BuildArrayValue([]Value{BuildStringValue("jane")})
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantGroup, mockedEnv.GetRegisterMap()["seniors"])
+}
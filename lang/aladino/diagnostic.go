@@ -0,0 +1,162 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package aladino
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Diagnostic describes an error found on a spec of a reviewpad file.
+type Diagnostic struct {
+	// Source is the offending group, rule or workflow (e.g. rule is-large)
+	Source string
+	// Line of the error in the reviewpad file (0 when unknown)
+	Line int
+	// Column of the error inside the spec (1-based)
+	Column int
+	// Expected and Actual are only set on type mismatches
+	Expected Type
+	Actual   Type
+	Spec     string
+	Message  string
+	// offset of the error inside the spec
+	offset int
+}
+
+// newDiagnostic builds the diagnostic of an error on the spec declared in the given source and line.
+// The positions are the offsets in the spec of each expression as returned by parse.
+func newDiagnostic(source string, line int, spec string, positions map[Expr]int, err error) *Diagnostic {
+	diagnostic := &Diagnostic{
+		Source:  source,
+		Spec:    strings.TrimRight(spec, "\n"),
+		Message: err.Error(),
+	}
+
+	var parseErr *ParseError
+	var typeErr *TypeError
+
+	switch {
+	case errors.As(err, &parseErr):
+		diagnostic.offset = parseErr.Offset
+		if parseErr.Message != "" {
+			diagnostic.Message = parseErr.Message
+		}
+	case errors.As(err, &typeErr):
+		diagnostic.offset = positions[typeErr.Expr]
+		diagnostic.Expected = typeErr.Expected
+		diagnostic.Actual = typeErr.Actual
+	}
+
+	specLine, column := lineAndColumn(diagnostic.Spec, diagnostic.offset)
+	diagnostic.Column = column
+	if line > 0 {
+		diagnostic.Line = line + specLine
+	}
+
+	return diagnostic
+}
+
+// lineAndColumn converts an offset into a 0-based line and a 1-based column.
+func lineAndColumn(input string, offset int) (int, int) {
+	if offset > len(input) {
+		offset = len(input)
+	}
+
+	line := strings.Count(input[:offset], "\n")
+	lineStart := strings.LastIndex(input[:offset], "\n") + 1
+
+	return line, offset - lineStart + 1
+}
+
+func (d *Diagnostic) Error() string {
+	var sb strings.Builder
+
+	if d.Line > 0 {
+		sb.WriteString(fmt.Sprintf("%v (line %v, column %v): %v", d.Source, d.Line, d.Column, d.Message))
+	} else {
+		sb.WriteString(fmt.Sprintf("%v (column %v): %v", d.Source, d.Column, d.Message))
+	}
+
+	if d.Expected != nil && d.Actual != nil {
+		sb.WriteString(fmt.Sprintf(": expected %v, got %v", formatType(d.Expected), formatType(d.Actual)))
+	}
+
+	sb.WriteString("\n")
+	sb.WriteString(d.Snippet())
+
+	return sb.String()
+}
+
+// Snippet returns the line of the spec with the error followed by a caret pointing to the error column.
+func (d *Diagnostic) Snippet() string {
+	specLine, column := lineAndColumn(d.Spec, d.offset)
+	line := strings.Split(d.Spec, "\n")[specLine]
+
+	// Keep the tabs so the caret is aligned with the spec
+	caret := make([]rune, 0, column)
+	for i, c := range line {
+		if i >= column-1 {
+			break
+		}
+		if c == '\t' {
+			caret = append(caret, '\t')
+		} else {
+			caret = append(caret, ' ')
+		}
+	}
+
+	return fmt.Sprintf("\t%v\n\t%v^", line, string(caret))
+}
+
+func formatTypes(tys []Type) string {
+	formattedTys := make([]string, len(tys))
+	for i, ty := range tys {
+		formattedTys[i] = formatType(ty)
+	}
+
+	return strings.Join(formattedTys, ", ")
+}
+
+// formatType returns the type as written by the user (e.g. []String).
+func formatType(ty Type) string {
+	if ty == nil {
+		return "Void"
+	}
+
+	switch ty.Kind() {
+	case BOOL_TYPE:
+		return "Bool"
+	case INT_TYPE:
+		return "Int"
+	case FLOAT_TYPE:
+		return "Float"
+	case STRING_TYPE:
+		return "String"
+	case TIME_TYPE:
+		return "Time"
+	case DURATION_TYPE:
+		return "Duration"
+	case ARRAY_OF_TYPE:
+		return fmt.Sprintf("[]%v", formatType(ty.(*ArrayOfType).elemType))
+	case ARRAY_TYPE:
+		return fmt.Sprintf("[%v]", formatTypes(ty.(*ArrayType).elemsType))
+	case JSON_TYPE:
+		return "JSON"
+	case TYPE_VAR:
+		return ty.(*TypeVar).name
+	case MAP_TYPE:
+		return fmt.Sprintf("Map[%v]", formatType(ty.(*MapType).valueType))
+	case FUNCTION_TYPE:
+		fnTy := ty.(*FunctionType)
+		if fnTy.variadic {
+			return fmt.Sprintf("(%v...) => %v", formatTypes(fnTy.paramTypes), formatType(fnTy.returnType))
+		}
+		return fmt.Sprintf("(%v) => %v", formatTypes(fnTy.paramTypes), formatType(fnTy.returnType))
+	}
+
+	return ty.Kind()
+}
@@ -0,0 +1,130 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package aladino
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewDiagnostic_WhenParseError(t *testing.T) {
+	spec := "$size() >"
+
+	_, positions, err := parse(spec)
+
+	gotDiagnostic := newDiagnostic("rule is-large", 5, spec, positions, err)
+
+	wantDiagnostic := &Diagnostic{
+		Source:  "rule is-large",
+		Line:    5,
+		Column:  10,
+		Spec:    spec,
+		Message: "syntax error: unexpected $end",
+		offset:  9,
+	}
+
+	assert.Equal(t, wantDiagnostic, gotDiagnostic)
+}
+
+func TestNewDiagnostic_WhenTypeError(t *testing.T) {
+	spec := "$returnStr(\"a\") == 1"
+
+	expr, positions, err := parse(spec)
+	if err != nil {
+		assert.FailNow(t, "parse failed: %v", err)
+	}
+
+	_, err = expr.typeinfer(MockTypeEnv())
+
+	gotDiagnostic := newDiagnostic("rule is-a", 0, spec, positions, err)
+
+	wantDiagnostic := &Diagnostic{
+		Source:   "rule is-a",
+		Column:   20,
+		Expected: BuildStringType(),
+		Actual:   BuildIntType(),
+		Spec:     spec,
+		Message:  "type inference failed",
+		offset:   19,
+	}
+
+	assert.Equal(t, wantDiagnostic, gotDiagnostic)
+}
+
+func TestNewDiagnostic_WhenOtherError(t *testing.T) {
+	gotDiagnostic := newDiagnostic("rule is-a", 3, "true", nil, fmt.Errorf("unexpected error"))
+
+	wantDiagnostic := &Diagnostic{
+		Source:  "rule is-a",
+		Line:    3,
+		Column:  1,
+		Spec:    "true",
+		Message: "unexpected error",
+	}
+
+	assert.Equal(t, wantDiagnostic, gotDiagnostic)
+}
+
+func TestNewDiagnostic_WhenMultilineSpec(t *testing.T) {
+	spec := "true &&\n\t$zeroConst()"
+
+	gotDiagnostic := newDiagnostic("rule is-a", 3, spec, nil, &ParseError{Offset: 9, Message: "some error"})
+
+	assert.Equal(t, 4, gotDiagnostic.Line)
+	assert.Equal(t, 2, gotDiagnostic.Column)
+	assert.Equal(t, "\t\t$zeroConst()\n\t\t^", gotDiagnostic.Snippet())
+}
+
+func TestDiagnosticError(t *testing.T) {
+	diagnostic := &Diagnostic{
+		Source:   "rule is-large",
+		Line:     12,
+		Column:   11,
+		Expected: BuildIntType(),
+		Actual:   BuildStringType(),
+		Spec:     "$size() > \"10\"",
+		Message:  "type inference failed",
+		offset:   10,
+	}
+
+	wantErr := "rule is-large (line 12, column 11): type inference failed: expected Int, got String\n" +
+		"\t$size() > \"10\"\n" +
+		"\t          ^"
+
+	assert.EqualError(t, diagnostic, wantErr)
+}
+
+func TestDiagnosticError_WhenLineIsUnknown(t *testing.T) {
+	diagnostic := &Diagnostic{
+		Source:  "workflow test",
+		Column:  1,
+		Spec:    "$close(1)",
+		Message: "type inference failed: mismatch in arg types on close",
+	}
+
+	wantErr := "workflow test (column 1): type inference failed: mismatch in arg types on close\n" +
+		"\t$close(1)\n" +
+		"\t^"
+
+	assert.EqualError(t, diagnostic, wantErr)
+}
+
+func TestFormatType(t *testing.T) {
+	assert.Equal(t, "Void", formatType(nil))
+	assert.Equal(t, "Bool", formatType(BuildBoolType()))
+	assert.Equal(t, "Int", formatType(BuildIntType()))
+	assert.Equal(t, "String", formatType(BuildStringType()))
+	assert.Equal(t, "Time", formatType(BuildTimeType()))
+	assert.Equal(t, "Duration", formatType(BuildDurationType()))
+	assert.Equal(t, "[]String", formatType(BuildArrayOfType(BuildStringType())))
+	assert.Equal(t, "[Int, String]", formatType(BuildArrayType([]Type{BuildIntType(), BuildStringType()})))
+	assert.Equal(t, "Map[String]", formatType(BuildMapType(BuildStringType())))
+	assert.Equal(t, "([]a) => Int", formatType(BuildFunctionType([]Type{BuildArrayOfType(BuildTypeVar("a"))}, BuildIntType())))
+	assert.Equal(t, "(String) => Bool", formatType(BuildFunctionType([]Type{BuildStringType()}, BuildBoolType())))
+	assert.Equal(t, "(String...) => String", formatType(BuildVariadicFunctionType([]Type{BuildStringType()}, BuildStringType())))
+	assert.Equal(t, "() => Void", formatType(BuildFunctionType([]Type{}, nil)))
+}
@@ -6,6 +6,7 @@ package aladino
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/google/go-github/v42/github"
 	"github.com/reviewpad/reviewpad/v3/collector"
@@ -30,6 +31,9 @@ type Env interface {
 	GetBuiltIns() *BuiltIns
 	GetReport() *Report
 	GetEventPayload() interface{}
+	GetEventData() interface{}
+	GetClock() Clock
+	GetMemo() Memo
 }
 
 type BaseEnv struct {
@@ -43,6 +47,18 @@ type BaseEnv struct {
 	BuiltIns     *BuiltIns
 	Report       *Report
 	EventPayload interface{}
+	// EventData is the JSON of the event payload as sent in the webhook (see utils.GetEventPayloadField)
+	EventData interface{}
+	Clock     Clock
+	Memo      Memo
+}
+
+// RawEventPayload is an event payload along with the JSON it was parsed from.
+// Given as the event payload of the env, every field of the JSON can be read (e.g. with $event),
+// including the ones that the parsed payload does not have.
+type RawEventPayload struct {
+	Payload interface{}
+	JSON    []byte
 }
 
 func (e *BaseEnv) GetCtx() context.Context {
@@ -85,19 +101,83 @@ func (e *BaseEnv) GetEventPayload() interface{} {
 	return e.EventPayload
 }
 
+func (e *BaseEnv) GetEventData() interface{} {
+	return e.EventData
+}
+
+func (e *BaseEnv) GetClock() Clock {
+	return e.Clock
+}
+
+func (e *BaseEnv) GetMemo() Memo {
+	return e.Memo
+}
+
+// scopedEnv is an environment nested in another one.
+// Its registers shadow the ones of the enclosing environment and are not visible outside of it.
+type scopedEnv struct {
+	Env
+	registerMap RegisterMap
+}
+
+func newScopedEnv(e Env, registers RegisterMap) Env {
+	return &scopedEnv{
+		Env:         e,
+		registerMap: e.GetRegisterMap().extend(registers),
+	}
+}
+
+func (e *scopedEnv) GetRegisterMap() RegisterMap {
+	return e.registerMap
+}
+
+// extend returns a copy of the register map where the given registers shadow the existing ones.
+func (registerMap RegisterMap) extend(registers RegisterMap) RegisterMap {
+	scope := make(RegisterMap, len(registerMap)+len(registers))
+	for name, value := range registerMap {
+		scope[name] = value
+	}
+
+	for name, value := range registers {
+		scope[name] = value
+	}
+
+	return scope
+}
+
+// extend returns a copy of the type environment where the given bindings shadow the existing ones.
+func (env TypeEnv) extend(bindings TypeEnv) TypeEnv {
+	scope := make(TypeEnv, len(env)+len(bindings))
+	for name, ty := range env {
+		scope[name] = ty
+	}
+
+	for name, ty := range bindings {
+		scope[name] = ty
+	}
+
+	return scope
+}
+
 func NewTypeEnv(e Env) TypeEnv {
+	return newTypeEnvFromBuiltIns(e.GetBuiltIns())
+}
+
+func newTypeEnvFromBuiltIns(builtIns *BuiltIns) TypeEnv {
 	builtInsType := make(map[string]Type)
-	for builtInName, builtInFunction := range e.GetBuiltIns().Functions {
+	for builtInName, builtInFunction := range builtIns.Functions {
 		builtInsType[builtInName] = builtInFunction.Type
 	}
 
-	for builtInName, builtInAction := range e.GetBuiltIns().Actions {
+	for builtInName, builtInAction := range builtIns.Actions {
 		builtInsType[builtInName] = builtInAction.Type
 	}
 
 	return TypeEnv(builtInsType)
 }
 
+// NewEvalEnv builds the env to evaluate Aladino over the pull request.
+// When clock is nil, the env tells the current time of the system.
 func NewEvalEnv(
 	ctx context.Context,
 	gitHubClient *github.Client,
@@ -106,6 +186,7 @@ func NewEvalEnv(
 	pullRequest *github.PullRequest,
 	eventPayload interface{},
 	builtIns *BuiltIns,
+	clock Clock,
 ) (Env, error) {
 	owner := utils.GetPullRequestBaseOwnerName(pullRequest)
 	repo := utils.GetPullRequestBaseRepoName(pullRequest)
@@ -127,6 +208,15 @@ func NewEvalEnv(
 		patchMap[file.GetFilename()] = patchFile
 	}
 
+	eventPayload, eventData, err := decodeEventPayload(eventPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	if clock == nil {
+		clock = NewSystemClock()
+	}
+
 	patch := Patch(patchMap)
 	registerMap := RegisterMap(make(map[string]Value))
 	report := &Report{WorkflowDetails: make(map[string]ReportWorkflowDetails, 0)}
@@ -139,10 +229,42 @@ func NewEvalEnv(
 		PullRequest:  pullRequest,
 		Patch:        patch,
 		RegisterMap:  registerMap,
-		BuiltIns:     builtIns,
+		BuiltIns:     ownBuiltIns(builtIns),
 		Report:       report,
 		EventPayload: eventPayload,
+		EventData:    eventData,
+		Clock:        clock,
+		Memo:         make(Memo),
 	}
 
 	return input, nil
 }
+
+// decodeEventPayload returns the parsed event payload along with its JSON, which is decoded once for the whole run.
+// When the event payload is not a RawEventPayload, its JSON is the one of the parsed payload.
+func decodeEventPayload(eventPayload interface{}) (interface{}, interface{}, error) {
+	if eventPayload == nil {
+		return nil, nil, nil
+	}
+
+	if rawEventPayload, ok := eventPayload.(*RawEventPayload); ok {
+		eventData, err := utils.DecodeEventPayload(rawEventPayload.JSON)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return rawEventPayload.Payload, eventData, nil
+	}
+
+	rawPayload, err := json.Marshal(eventPayload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	eventData, err := utils.DecodeEventPayload(rawPayload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return eventPayload, eventData, nil
+}
@@ -150,6 +150,28 @@ func TestGetReport_WithDefaultEnv(t *testing.T) {
 	assert.Equal(t, wantReport, gotReport)
 }
 
+func TestGetMemo_WithDefaultEnv(t *testing.T) {
+	mockedEnv, err := aladino.MockDefaultEnv(nil, nil)
+	if err != nil {
+		log.Fatalf("mockDefaultEnv failed: %v", err)
+	}
+
+	assert.Equal(t, aladino.Memo{}, mockedEnv.GetMemo())
+}
+
+func TestGetClock_WithDefaultEnv(t *testing.T) {
+	mockedEnv, err := aladino.MockDefaultEnv(nil, nil)
+	if err != nil {
+		log.Fatalf("mockDefaultEnv failed: %v", err)
+	}
+
+	wantNow := aladino.DefaultMockNow
+
+	gotNow := mockedEnv.GetClock().Now()
+
+	assert.Equal(t, wantNow, gotNow)
+}
+
 func TestNewTypeEnv_WithDefaultEnv(t *testing.T) {
 	mockedEnv, err := aladino.MockDefaultEnv(nil, nil)
 	if err != nil {
@@ -206,6 +228,7 @@ func TestNewEvalEnv_WhenGetPullRequestFilesFails(t *testing.T) {
 		mockedPullRequest,
 		nil,
 		aladino.MockBuiltIns(),
+		aladino.NewFixedClock(aladino.DefaultMockNow),
 	)
 
 	assert.Nil(t, env)
@@ -245,6 +268,7 @@ func TestNewEvalEnv_WhenNewFileFails(t *testing.T) {
 		mockedPullRequest,
 		nil,
 		aladino.MockBuiltIns(),
+		aladino.NewFixedClock(aladino.DefaultMockNow),
 	)
 
 	assert.Nil(t, env)
@@ -290,6 +314,7 @@ func TestNewEvalEnv(t *testing.T) {
 		mockedPullRequest,
 		nil,
 		aladino.MockBuiltIns(),
+		aladino.NewFixedClock(aladino.DefaultMockNow),
 	)
 
 	mockedFile1 := &aladino.File{
@@ -317,6 +342,8 @@ func TestNewEvalEnv(t *testing.T) {
 		Report:      &aladino.Report{WorkflowDetails: make(map[string]aladino.ReportWorkflowDetails)},
 		// TODO: Mock an event
 		EventPayload: nil,
+		Clock:        aladino.NewFixedClock(aladino.DefaultMockNow),
+		Memo:         aladino.Memo{},
 	}
 
 	assert.Nil(t, err)
@@ -328,6 +355,8 @@ func TestNewEvalEnv(t *testing.T) {
 	assert.Equal(t, wantEnv.Patch, gotEnv.GetPatch())
 	assert.Equal(t, wantEnv.RegisterMap, gotEnv.GetRegisterMap())
 	assert.Equal(t, wantEnv.EventPayload, gotEnv.GetEventPayload())
+	assert.Equal(t, wantEnv.Clock, gotEnv.GetClock())
+	assert.Equal(t, wantEnv.Memo, gotEnv.GetMemo())
 
 	assert.Equal(t, len(wantEnv.BuiltIns.Functions), len(gotEnv.GetBuiltIns().Functions))
 	assert.Equal(t, len(wantEnv.BuiltIns.Actions), len(gotEnv.GetBuiltIns().Actions))
@@ -342,3 +371,50 @@ func TestNewEvalEnv(t *testing.T) {
 
 	assert.Equal(t, wantEnv.Report, gotEnv.GetReport())
 }
+
+func TestNewEvalEnv_WhenClockIsNil(t *testing.T) {
+	mockedGithubClient := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatch(
+			mock.GetReposPullsByOwnerByRepoByPullNumber,
+			aladino.GetDefaultMockPullRequestDetails(),
+		),
+		mock.WithRequestMatch(
+			mock.GetReposPullsFilesByOwnerByRepoByPullNumber,
+			&[]*github.CommitFile{},
+		),
+	))
+
+	ctx := context.Background()
+	mockedPullRequest, _, err := mockedGithubClient.PullRequests.Get(
+		ctx,
+		aladino.DefaultMockPrOwner,
+		aladino.DefaultMockPrRepoName,
+		aladino.DefaultMockPrNum,
+	)
+	if err != nil {
+		log.Fatalf("couldn't get pull request: %v", err)
+	}
+
+	env, err := aladino.NewEvalEnv(
+		ctx,
+		mockedGithubClient,
+		nil,
+		aladino.DefaultMockCollector,
+		mockedPullRequest,
+		nil,
+		aladino.MockBuiltIns(),
+		nil,
+	)
+
+	assert.Nil(t, err)
+	assert.Equal(t, aladino.NewSystemClock(), env.GetClock())
+
+	relativeTime, err := aladino.BuildRelativeTimeConst("2 days ago")
+	if err != nil {
+		assert.FailNow(t, "BuildRelativeTimeConst failed: %v", err)
+	}
+
+	_, err = relativeTime.Eval(env)
+
+	assert.Nil(t, err)
+}
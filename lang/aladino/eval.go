@@ -5,40 +5,233 @@
 package aladino
 
 import (
+	"errors"
 	"fmt"
+	"math"
+	"regexp"
+	"strings"
 )
 
 func (u *UnaryOp) Eval(e Env) (Value, error) {
-	exprValue, exprErr := u.expr.Eval(e)
+	exprValue, exprErr := evalExpr(e, u.expr)
 	if exprErr != nil {
 		return nil, exprErr
 	}
 
 	operator := u.op
 
+	// JSON operands are only known to have the right kind at this point
+	if !hasOperandKind(operator, exprValue) {
+		return nil, fmt.Errorf("eval: invalid operand of %v", operator.getOperator())
+	}
+
 	return operator.Eval(exprValue), nil
 }
 
+// hasOperandKind checks if a value can be the operand of a unary operator.
+func hasOperandKind(op UnaryOperator, value Value) bool {
+	if op.getOperator() == NOT_OP {
+		return value.HasKindOf(BOOL_VALUE)
+	}
+
+	return isNumericValue(value)
+}
+
 func (b *BinaryOp) Eval(e Env) (Value, error) {
-	leftValue, leftErr := b.lhs.Eval(e)
+	leftValue, leftErr := evalExpr(e, b.lhs)
 	if leftErr != nil {
 		return nil, leftErr
 	}
 
-	rightValue, rightErr := b.rhs.Eval(e)
+	if value, ok := shortCircuit(b.op, leftValue); ok {
+		return value, nil
+	}
+
+	rightValue, rightErr := evalExpr(e, b.rhs)
 	if rightErr != nil {
 		return nil, rightErr
 	}
 
-	if !leftValue.HasKindOf(rightValue.Kind()) {
+	operator := b.op
+
+	if !haveCompatibleKinds(operator, leftValue, rightValue) {
 		return nil, fmt.Errorf("eval: left and right operand have different kinds")
 	}
 
-	operator := b.op
+	if isDivision(operator) && isZero(rightValue) {
+		return nil, fmt.Errorf("eval: division by zero")
+	}
+
+	if regexOperator, ok := operator.(regexOperator); ok {
+		pattern, err := compileOperand(regexOperator, rightValue)
+		if err != nil {
+			return nil, err
+		}
+
+		return regexOperator.evalMatch(leftValue, pattern), nil
+	}
 
 	return operator.Eval(leftValue, rightValue), nil
 }
 
+// haveCompatibleKinds checks if the operands of a binary operation can be combined.
+// Besides operands of the same kind, ints are promoted to floats when mixed with floats,
+// times and durations can be added and subtracted to each other and
+// the right operand of the in operator is an array.
+func haveCompatibleKinds(op BinaryOperator, leftValue, rightValue Value) bool {
+	if op.getOperator() == IN_OP {
+		return rightValue.HasKindOf(ARRAY_VALUE)
+	}
+
+	return leftValue.HasKindOf(rightValue.Kind()) || isMixedNumeric(leftValue, rightValue) || isTimeArithmetic(op, leftValue, rightValue)
+}
+
+func isTimeArithmetic(op BinaryOperator, leftValue, rightValue Value) bool {
+	if op.getOperator() != ADD_OP && op.getOperator() != SUB_OP {
+		return false
+	}
+
+	isTimeOrDuration := func(value Value) bool {
+		return value.HasKindOf(TIME_VALUE) || value.HasKindOf(DURATION_VALUE)
+	}
+
+	return isTimeOrDuration(leftValue) && isTimeOrDuration(rightValue)
+}
+
+func isNumericValue(value Value) bool {
+	return value.HasKindOf(INT_VALUE) || value.HasKindOf(FLOAT_VALUE)
+}
+
+func isMixedNumeric(leftValue, rightValue Value) bool {
+	return isNumericValue(leftValue) && isNumericValue(rightValue)
+}
+
+// isFloatArithmetic checks if an operation on numbers must be computed with floats.
+func isFloatArithmetic(leftValue, rightValue Value) bool {
+	return leftValue.HasKindOf(FLOAT_VALUE) || rightValue.HasKindOf(FLOAT_VALUE)
+}
+
+// toFloat converts a number (int or float) to a float.
+func toFloat(value Value) float64 {
+	if fVal, ok := value.(*FloatValue); ok {
+		return fVal.Val
+	}
+
+	return float64(value.(*IntValue).Val)
+}
+
+func isZero(value Value) bool {
+	return isNumericValue(value) && toFloat(value) == 0
+}
+
+// equalValues compares two values where an int is equal to a float with the same number.
+func equalValues(lhs, rhs Value) bool {
+	if isMixedNumeric(lhs, rhs) {
+		return toFloat(lhs) == toFloat(rhs)
+	}
+
+	return lhs.Equals(rhs)
+}
+
+// compare returns a negative number, zero or a positive number
+// when lhs is respectively lower, equal or greater than rhs.
+func compare(lhs, rhs Value) int {
+	if lhs.HasKindOf(STRING_VALUE) {
+		// Strings are compared lexicographically
+		return strings.Compare(lhs.(*StringValue).Val, rhs.(*StringValue).Val)
+	}
+
+	if isFloatArithmetic(lhs, rhs) {
+		leftValue := toFloat(lhs)
+		rightValue := toFloat(rhs)
+
+		switch {
+		case leftValue < rightValue:
+			return -1
+		case leftValue > rightValue:
+			return 1
+		}
+
+		return 0
+	}
+
+	return scalar(lhs) - scalar(rhs)
+}
+
+// scalar returns the integer that represents an ordered value (ints, times and durations).
+func scalar(value Value) int {
+	switch val := value.(type) {
+	case *TimeValue:
+		return val.Val
+	case *DurationValue:
+		return val.Val
+	}
+
+	return value.(*IntValue).Val
+}
+
+func isDivision(op BinaryOperator) bool {
+	return op.getOperator() == DIV_OP || op.getOperator() == MOD_OP
+}
+
+// ErrInvalidRegex is returned when the right operand of =~ or !~ is not a valid regular expression.
+var ErrInvalidRegex = errors.New("invalid regular expression")
+
+// regexOperator is a binary operator over a string and the regular expression compiled from its right operand.
+type regexOperator interface {
+	getPattern() *regexp.Regexp
+	setPattern(pattern *regexp.Regexp)
+	evalMatch(lhs Value, pattern *regexp.Regexp) Value
+}
+
+// compilePattern compiles the right operand of a =~ or !~ operation.
+func compilePattern(pattern string) (*regexp.Regexp, error) {
+	regex, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidRegex, err)
+	}
+
+	return regex, nil
+}
+
+// compileOperand returns the regular expression of the right operand of a =~ or !~ operation,
+// which is only compiled when it is not a constant already compiled by the type checker.
+func compileOperand(op regexOperator, rhs Value) (*regexp.Regexp, error) {
+	if pattern := op.getPattern(); pattern != nil {
+		return pattern, nil
+	}
+
+	pattern, err := compilePattern(rhs.(*StringValue).Val)
+	if err != nil {
+		return nil, fmt.Errorf("eval: %w", err)
+	}
+
+	return pattern, nil
+}
+
+// shortCircuit returns the value of a && or || operation when it is decided by the left operand.
+// In that case the right operand must not be evaluated.
+func shortCircuit(op BinaryOperator, leftValue Value) (Value, bool) {
+	// The left operand is not a bool when it is a JSON value of another kind, which is reported by haveCompatibleKinds
+	boolValue, ok := leftValue.(*BoolValue)
+	if !ok {
+		return nil, false
+	}
+
+	switch op.getOperator() {
+	case AND_OP:
+		if !boolValue.Val {
+			return BuildFalseValue(), true
+		}
+	case OR_OP:
+		if boolValue.Val {
+			return BuildTrueValue(), true
+		}
+	}
+
+	return nil, false
+}
+
 func (v *Variable) Eval(e Env) (Value, error) {
 	variableName := v.ident
 
@@ -51,7 +244,7 @@ func (v *Variable) Eval(e Env) (Value, error) {
 		return nil, fmt.Errorf("eval: failure on %v", variableName)
 	}
 
-	return fn.Code(e, []Value{})
+	return callBuiltIn(e, variableName, fn, []Value{})
 }
 
 func (b *BoolConst) Eval(e Env) (Value, error) {
@@ -66,10 +259,40 @@ func (i *IntConst) Eval(e Env) (Value, error) {
 	return BuildIntValue(i.value), nil
 }
 
+func (f *FloatConst) Eval(e Env) (Value, error) {
+	return BuildFloatValue(f.value), nil
+}
+
+func (t *TimeConst) Eval(e Env) (Value, error) {
+	return BuildTimeValue(t.value), nil
+}
+
+func (r *RelativeTimeConst) Eval(e Env) (Value, error) {
+	return BuildTimeValue(int(r.before(e.GetClock().Now()).Unix())), nil
+}
+
+func (d *DurationConst) Eval(e Env) (Value, error) {
+	return BuildDurationValue(d.value), nil
+}
+
+func (c *Conditional) Eval(e Env) (Value, error) {
+	condition, err := evalExpr(e, c.condition)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the chosen branch is evaluated
+	if condition.(*BoolValue).Val {
+		return evalExpr(e, c.thenExpr)
+	}
+
+	return evalExpr(e, c.elseExpr)
+}
+
 func (fc *FunctionCall) Eval(e Env) (Value, error) {
 	args := make([]Value, len(fc.arguments))
 	for i, elem := range fc.arguments {
-		value, err := elem.Eval(e)
+		value, err := evalExpr(e, elem)
 
 		if err != nil {
 			return nil, err
@@ -83,29 +306,38 @@ func (fc *FunctionCall) Eval(e Env) (Value, error) {
 		return nil, fmt.Errorf("eval: failure on %v", fc.name.ident)
 	}
 
-	return fn.Code(e, args)
+	args, err := bindArguments(fc, fn.Type, args)
+	if err != nil {
+		return nil, err
+	}
+
+	return callBuiltIn(e, fc.name.ident, fn, args)
 }
 
 func (lambda *Lambda) Eval(e Env) (Value, error) {
-	fn := func(args []Value) Value {
-		// TODO: We need to deep copy the register map and use in the eval at L79
+	fn := func(args []Value) (Value, error) {
+		if len(args) != len(lambda.parameters) {
+			return nil, fmt.Errorf("eval: lambda expects %v arguments but got %v", len(lambda.parameters), len(args))
+		}
+
+		// The parameters are only visible in the body of the lambda
+		registers := make(RegisterMap, len(lambda.parameters))
 		for i, elem := range lambda.parameters {
 			paramIdent := elem.(*TypedExpr).expr.(*Variable).ident
 
-			e.GetRegisterMap()[paramIdent] = args[i]
+			registers[paramIdent] = args[i]
 		}
 
-		fnVal, err := lambda.body.Eval(e)
-		if err != nil {
-			return nil
-		}
-
-		return fnVal
+		return evalExpr(newScopedEnv(e, registers), lambda.body)
 	}
 
 	return BuildFunctionValue(fn), nil
 }
 
+func (na *NamedArg) Eval(e Env) (Value, error) {
+	return evalExpr(e, na.value)
+}
+
 func (te *TypedExpr) Eval(e Env) (Value, error) {
 	return te.expr.Eval(e)
 }
@@ -113,7 +345,7 @@ func (te *TypedExpr) Eval(e Env) (Value, error) {
 func (a *Array) Eval(e Env) (Value, error) {
 	values := make([]Value, len(a.elems))
 	for i, elem := range a.elems {
-		value, err := elem.Eval(e)
+		value, err := evalExpr(e, elem)
 
 		if err != nil {
 			return nil, err
@@ -151,12 +383,20 @@ func (op *NotOp) Eval(exprVal Value) Value {
 	return BuildBoolValue(!exprVal.(*BoolValue).Val)
 }
 
+func (op *NegOp) Eval(exprVal Value) Value {
+	if fVal, ok := exprVal.(*FloatValue); ok {
+		return BuildFloatValue(-fVal.Val)
+	}
+
+	return BuildIntValue(-exprVal.(*IntValue).Val)
+}
+
 func (op *EqOp) Eval(lhs, rhs Value) Value {
-	return BuildBoolValue(lhs.Equals(rhs))
+	return BuildBoolValue(equalValues(lhs, rhs))
 }
 
 func (op *NeqOp) Eval(lhs, rhs Value) Value {
-	return BuildBoolValue(!lhs.Equals(rhs))
+	return BuildBoolValue(!equalValues(lhs, rhs))
 }
 
 func (op *AndOp) Eval(lhs, rhs Value) Value {
@@ -174,29 +414,121 @@ func (op *OrOp) Eval(lhs, rhs Value) Value {
 }
 
 func (op *LessThanOp) Eval(lhs, rhs Value) Value {
-	leftValue := lhs.(*IntValue).Val
-	rightValue := rhs.(*IntValue).Val
-
-	return BuildBoolValue(leftValue < rightValue)
+	return BuildBoolValue(compare(lhs, rhs) < 0)
 }
 
 func (op *LessEqThanOp) Eval(lhs, rhs Value) Value {
-	leftValue := lhs.(*IntValue).Val
-	rightValue := rhs.(*IntValue).Val
-
-	return BuildBoolValue(leftValue <= rightValue)
+	return BuildBoolValue(compare(lhs, rhs) <= 0)
 }
 
 func (op *GreaterThanOp) Eval(lhs, rhs Value) Value {
+	return BuildBoolValue(compare(lhs, rhs) > 0)
+}
+
+func (op *GreaterEqThanOp) Eval(lhs, rhs Value) Value {
+	return BuildBoolValue(compare(lhs, rhs) >= 0)
+}
+
+func (op *AddOp) Eval(lhs, rhs Value) Value {
+	if isFloatArithmetic(lhs, rhs) {
+		return BuildFloatValue(toFloat(lhs) + toFloat(rhs))
+	}
+
+	leftValue := scalar(lhs)
+	rightValue := scalar(rhs)
+
+	switch {
+	case lhs.HasKindOf(TIME_VALUE) || rhs.HasKindOf(TIME_VALUE):
+		return BuildTimeValue(leftValue + rightValue)
+	case lhs.HasKindOf(DURATION_VALUE):
+		return BuildDurationValue(leftValue + rightValue)
+	}
+
+	return BuildIntValue(leftValue + rightValue)
+}
+
+func (op *SubOp) Eval(lhs, rhs Value) Value {
+	if isFloatArithmetic(lhs, rhs) {
+		return BuildFloatValue(toFloat(lhs) - toFloat(rhs))
+	}
+
+	leftValue := scalar(lhs)
+	rightValue := scalar(rhs)
+
+	switch {
+	case lhs.HasKindOf(TIME_VALUE) && rhs.HasKindOf(TIME_VALUE):
+		return BuildDurationValue(leftValue - rightValue)
+	case lhs.HasKindOf(TIME_VALUE):
+		return BuildTimeValue(leftValue - rightValue)
+	case lhs.HasKindOf(DURATION_VALUE):
+		return BuildDurationValue(leftValue - rightValue)
+	}
+
+	return BuildIntValue(leftValue - rightValue)
+}
+
+func (op *MulOp) Eval(lhs, rhs Value) Value {
+	if isFloatArithmetic(lhs, rhs) {
+		return BuildFloatValue(toFloat(lhs) * toFloat(rhs))
+	}
+
 	leftValue := lhs.(*IntValue).Val
 	rightValue := rhs.(*IntValue).Val
 
-	return BuildBoolValue(leftValue > rightValue)
+	return BuildIntValue(leftValue * rightValue)
 }
 
-func (op *GreaterEqThanOp) Eval(lhs, rhs Value) Value {
+// Pre-condition: rhs is not zero
+func (op *DivOp) Eval(lhs, rhs Value) Value {
+	return BuildFloatValue(toFloat(lhs) / toFloat(rhs))
+}
+
+// Pre-condition: rhs is not zero
+func (op *ModOp) Eval(lhs, rhs Value) Value {
+	if isFloatArithmetic(lhs, rhs) {
+		return BuildFloatValue(math.Mod(toFloat(lhs), toFloat(rhs)))
+	}
+
 	leftValue := lhs.(*IntValue).Val
 	rightValue := rhs.(*IntValue).Val
 
-	return BuildBoolValue(leftValue >= rightValue)
+	return BuildIntValue(leftValue % rightValue)
+}
+
+// Pre-condition: rhs is a valid regular expression
+func (op *MatchOp) Eval(lhs, rhs Value) Value {
+	pattern, err := compileOperand(op, rhs)
+	if err != nil {
+		panic(err)
+	}
+
+	return op.evalMatch(lhs, pattern)
+}
+
+func (op *MatchOp) evalMatch(lhs Value, pattern *regexp.Regexp) Value {
+	return BuildBoolValue(pattern.MatchString(lhs.(*StringValue).Val))
+}
+
+// Pre-condition: rhs is a valid regular expression
+func (op *NotMatchOp) Eval(lhs, rhs Value) Value {
+	pattern, err := compileOperand(op, rhs)
+	if err != nil {
+		panic(err)
+	}
+
+	return op.evalMatch(lhs, pattern)
+}
+
+func (op *NotMatchOp) evalMatch(lhs Value, pattern *regexp.Regexp) Value {
+	return BuildBoolValue(!pattern.MatchString(lhs.(*StringValue).Val))
+}
+
+func (op *InOp) Eval(lhs, rhs Value) Value {
+	for _, elem := range rhs.(*ArrayValue).Vals {
+		if equalValues(lhs, elem) {
+			return BuildTrueValue()
+		}
+	}
+
+	return BuildFalseValue()
 }
@@ -7,6 +7,7 @@ package aladino_test
 import (
 	"log"
 	"testing"
+	"time"
 
 	"github.com/reviewpad/reviewpad/v3/lang/aladino"
 	"github.com/stretchr/testify/assert"
@@ -137,6 +138,80 @@ func TestEval_OnBinaryOp_WhenFalse(t *testing.T) {
 	assert.Equal(t, wantVal, gotVal)
 }
 
+func TestEval_OnBinaryOp_WhenAndOpLeftOperandIsFalse(t *testing.T) {
+	mockedEnv, err := aladino.MockDefaultEnv(nil, nil)
+	if err != nil {
+		log.Fatalf("mockDefaultEnv failed: %v", err)
+	}
+
+	// The right operand is not evaluated, otherwise it would fail
+	binaryOp, err := aladino.Parse("false && $nonBuiltIn()")
+	if err != nil {
+		log.Fatalf("parse failed: %v", err)
+	}
+
+	gotVal, err := binaryOp.Eval(mockedEnv)
+
+	wantVal := aladino.BuildFalseValue()
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestEval_OnBinaryOp_WhenAndOpLeftOperandIsTrue(t *testing.T) {
+	mockedEnv, err := aladino.MockDefaultEnv(nil, nil)
+	if err != nil {
+		log.Fatalf("mockDefaultEnv failed: %v", err)
+	}
+
+	binaryOp, err := aladino.Parse("true && $nonBuiltIn()")
+	if err != nil {
+		log.Fatalf("parse failed: %v", err)
+	}
+
+	gotVal, err := binaryOp.Eval(mockedEnv)
+
+	assert.Nil(t, gotVal)
+	assert.EqualError(t, err, "eval: failure on nonBuiltIn")
+}
+
+func TestEval_OnBinaryOp_WhenOrOpLeftOperandIsTrue(t *testing.T) {
+	mockedEnv, err := aladino.MockDefaultEnv(nil, nil)
+	if err != nil {
+		log.Fatalf("mockDefaultEnv failed: %v", err)
+	}
+
+	// The right operand is not evaluated, otherwise it would fail
+	binaryOp, err := aladino.Parse("true || $nonBuiltIn()")
+	if err != nil {
+		log.Fatalf("parse failed: %v", err)
+	}
+
+	gotVal, err := binaryOp.Eval(mockedEnv)
+
+	wantVal := aladino.BuildTrueValue()
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestEval_OnBinaryOp_WhenOrOpLeftOperandIsFalse(t *testing.T) {
+	mockedEnv, err := aladino.MockDefaultEnv(nil, nil)
+	if err != nil {
+		log.Fatalf("mockDefaultEnv failed: %v", err)
+	}
+
+	binaryOp, err := aladino.Parse("false || $nonBuiltIn()")
+	if err != nil {
+		log.Fatalf("parse failed: %v", err)
+	}
+
+	gotVal, err := binaryOp.Eval(mockedEnv)
+
+	assert.Nil(t, gotVal)
+	assert.EqualError(t, err, "eval: failure on nonBuiltIn")
+}
+
 func TestEval_OnVariable_WhenVariableIsRegistered(t *testing.T) {
 	mockedEnv, err := aladino.MockDefaultEnv(nil, nil)
 	if err != nil {
@@ -324,10 +399,12 @@ func TestEval_OnLambda_WhenLambdaBodyEvalFails(t *testing.T) {
 
 	gotFn, err := lambda.Eval(mockedEnv)
 
-	gotVal := gotFn.(*aladino.FunctionValue).Fn([]aladino.Value{})
-
 	assert.Nil(t, err)
+
+	gotVal, err := gotFn.(*aladino.FunctionValue).Fn([]aladino.Value{})
+
 	assert.Nil(t, gotVal)
+	assert.EqualError(t, err, "eval: failure on nonBuiltIn")
 }
 
 func TestEval_OnLambda(t *testing.T) {
@@ -349,7 +426,9 @@ func TestEval_OnLambda(t *testing.T) {
 
 	gotFn, err := lambda.Eval(mockedEnv)
 
-	gotVal := gotFn.(*aladino.FunctionValue).Fn([]aladino.Value{aladino.BuildIntValue(0)})
+	assert.Nil(t, err)
+
+	gotVal, err := gotFn.(*aladino.FunctionValue).Fn([]aladino.Value{aladino.BuildIntValue(0)})
 
 	wantVal := aladino.BuildTrueValue()
 
@@ -357,6 +436,104 @@ func TestEval_OnLambda(t *testing.T) {
 	assert.Equal(t, wantVal, gotVal)
 }
 
+func TestEval_OnLambda_WhenArgumentsDoNotMatchParameters(t *testing.T) {
+	mockedEnv, err := aladino.MockDefaultEnv(nil, nil)
+	if err != nil {
+		log.Fatalf("mockDefaultEnv failed: %v", err)
+	}
+
+	lambda, err := aladino.Parse("($x: Int => $x)")
+	if err != nil {
+		log.Fatalf("parse failed: %v", err)
+	}
+
+	gotFn, err := lambda.Eval(mockedEnv)
+
+	assert.Nil(t, err)
+
+	gotVal, err := gotFn.(*aladino.FunctionValue).Fn([]aladino.Value{})
+
+	assert.Nil(t, gotVal)
+	assert.EqualError(t, err, "eval: lambda expects 1 arguments but got 0")
+}
+
+func TestEval_OnLambda_WhenParameterShadowsRegister(t *testing.T) {
+	mockedEnv, err := aladino.MockDefaultEnv(nil, nil)
+	if err != nil {
+		log.Fatalf("mockDefaultEnv failed: %v", err)
+	}
+
+	outerVal := aladino.BuildStringValue("outer")
+	mockedEnv.GetRegisterMap()["x"] = outerVal
+
+	lambda, err := aladino.Parse("($x: String => $x)")
+	if err != nil {
+		log.Fatalf("parse failed: %v", err)
+	}
+
+	gotFn, err := lambda.Eval(mockedEnv)
+
+	assert.Nil(t, err)
+
+	gotVal, err := gotFn.(*aladino.FunctionValue).Fn([]aladino.Value{aladino.BuildStringValue("inner")})
+
+	wantVal := aladino.BuildStringValue("inner")
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantVal, gotVal)
+	assert.Equal(t, outerVal, mockedEnv.GetRegisterMap()["x"])
+}
+
+func TestEval_OnLambda_WhenParameterIsNotVisibleOutside(t *testing.T) {
+	mockedEnv, err := aladino.MockDefaultEnv(nil, nil)
+	if err != nil {
+		log.Fatalf("mockDefaultEnv failed: %v", err)
+	}
+
+	lambda, err := aladino.Parse("($x: Int => $x)")
+	if err != nil {
+		log.Fatalf("parse failed: %v", err)
+	}
+
+	gotFn, err := lambda.Eval(mockedEnv)
+
+	assert.Nil(t, err)
+
+	_, err = gotFn.(*aladino.FunctionValue).Fn([]aladino.Value{aladino.BuildIntValue(1)})
+
+	_, ok := mockedEnv.GetRegisterMap()["x"]
+
+	assert.Nil(t, err)
+	assert.False(t, ok)
+}
+
+func TestEval_OnLambda_WhenNested(t *testing.T) {
+	mockedEnv, err := aladino.MockDefaultEnv(nil, nil)
+	if err != nil {
+		log.Fatalf("mockDefaultEnv failed: %v", err)
+	}
+
+	lambda, err := aladino.Parse("($x: Int => ($x: Int, $y: Int => $x - $y))")
+	if err != nil {
+		log.Fatalf("parse failed: %v", err)
+	}
+
+	gotOuterFn, err := lambda.Eval(mockedEnv)
+
+	assert.Nil(t, err)
+
+	gotInnerFn, err := gotOuterFn.(*aladino.FunctionValue).Fn([]aladino.Value{aladino.BuildIntValue(100)})
+
+	assert.Nil(t, err)
+
+	gotVal, err := gotInnerFn.(*aladino.FunctionValue).Fn([]aladino.Value{aladino.BuildIntValue(3), aladino.BuildIntValue(1)})
+
+	wantVal := aladino.BuildIntValue(2)
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantVal, gotVal)
+}
+
 func TestEval_OnTypedExpr(t *testing.T) {
 	mockedEnv, err := aladino.MockDefaultEnv(nil, nil)
 	if err != nil {
@@ -581,3 +758,537 @@ func TestEval_OnGreaterEqThanOp(t *testing.T) {
 
 	assert.Equal(t, wantVal, gotVal)
 }
+
+func TestEval_OnNegOp(t *testing.T) {
+	negOp := &aladino.NegOp{}
+	gotVal := negOp.Eval(aladino.BuildIntValue(3))
+
+	wantVal := aladino.BuildIntValue(-3)
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestEval_OnAddOp(t *testing.T) {
+	addOp := &aladino.AddOp{}
+	gotVal := addOp.Eval(aladino.BuildIntValue(3), aladino.BuildIntValue(2))
+
+	wantVal := aladino.BuildIntValue(5)
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestEval_OnSubOp(t *testing.T) {
+	subOp := &aladino.SubOp{}
+	gotVal := subOp.Eval(aladino.BuildIntValue(3), aladino.BuildIntValue(5))
+
+	wantVal := aladino.BuildIntValue(-2)
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestEval_OnMulOp(t *testing.T) {
+	mulOp := &aladino.MulOp{}
+	gotVal := mulOp.Eval(aladino.BuildIntValue(3), aladino.BuildIntValue(2))
+
+	wantVal := aladino.BuildIntValue(6)
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestEval_OnDivOp(t *testing.T) {
+	divOp := &aladino.DivOp{}
+	gotVal := divOp.Eval(aladino.BuildIntValue(7), aladino.BuildIntValue(2))
+
+	wantVal := aladino.BuildFloatValue(3.5)
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestEval_OnModOp(t *testing.T) {
+	modOp := &aladino.ModOp{}
+	gotVal := modOp.Eval(aladino.BuildIntValue(7), aladino.BuildIntValue(2))
+
+	wantVal := aladino.BuildIntValue(1)
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestEval_OnArithmeticExpr(t *testing.T) {
+	mockedEnv, err := aladino.MockDefaultEnv(nil, nil)
+	if err != nil {
+		log.Fatalf("mockDefaultEnv failed: %v", err)
+	}
+
+	expr, err := aladino.Parse("-2 + 3 * 4 - 10 / (4 - 2) % 3 == 8")
+	if err != nil {
+		log.Fatalf("parse failed: %v", err)
+	}
+
+	gotVal, err := expr.Eval(mockedEnv)
+
+	wantVal := aladino.BuildTrueValue()
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantVal, gotVal)
+}
+
+// Since floating point numbers, the division of two ints is no longer truncated (use $floor to get an int)
+func TestEval_OnDivExpr_WhenIntsAreDividedTheResultIsAFloat(t *testing.T) {
+	mockedEnv, err := aladino.MockDefaultEnv(nil, nil)
+	if err != nil {
+		log.Fatalf("mockDefaultEnv failed: %v", err)
+	}
+
+	expr, err := aladino.Parse("7 / 2")
+	if err != nil {
+		log.Fatalf("parse failed: %v", err)
+	}
+
+	gotType, err := aladino.TypeInference(mockedEnv, expr)
+	if err != nil {
+		log.Fatalf("type inference failed: %v", err)
+	}
+
+	gotVal, err := expr.Eval(mockedEnv)
+
+	assert.Nil(t, err)
+	assert.Equal(t, aladino.BuildFloatType(), gotType)
+	assert.Equal(t, aladino.BuildFloatValue(3.5), gotVal)
+}
+
+func TestEval_OnModOp_WhenFloat(t *testing.T) {
+	modOp := &aladino.ModOp{}
+	gotVal := modOp.Eval(aladino.BuildFloatValue(5.5), aladino.BuildIntValue(2))
+
+	wantVal := aladino.BuildFloatValue(1.5)
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestEval_OnNegOp_WhenFloat(t *testing.T) {
+	negOp := &aladino.NegOp{}
+	gotVal := negOp.Eval(aladino.BuildFloatValue(1.5))
+
+	wantVal := aladino.BuildFloatValue(-1.5)
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestEval_OnAddOp_WhenIntAndFloat(t *testing.T) {
+	addOp := &aladino.AddOp{}
+	gotVal := addOp.Eval(aladino.BuildIntValue(1), aladino.BuildFloatValue(0.5))
+
+	wantVal := aladino.BuildFloatValue(1.5)
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestEval_OnMulOp_WhenFloats(t *testing.T) {
+	mulOp := &aladino.MulOp{}
+	gotVal := mulOp.Eval(aladino.BuildFloatValue(1.5), aladino.BuildFloatValue(2))
+
+	wantVal := aladino.BuildFloatValue(3)
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestEval_OnEqOp_WhenIntAndFloat(t *testing.T) {
+	eqOp := &aladino.EqOp{}
+	gotVal := eqOp.Eval(aladino.BuildIntValue(1), aladino.BuildFloatValue(1.0))
+
+	wantVal := aladino.BuildTrueValue()
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestEval_OnLessThanOp_WhenIntAndFloat(t *testing.T) {
+	lessThanOp := &aladino.LessThanOp{}
+	gotVal := lessThanOp.Eval(aladino.BuildIntValue(1), aladino.BuildFloatValue(1.5))
+
+	wantVal := aladino.BuildTrueValue()
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestEval_OnRatio(t *testing.T) {
+	mockedEnv, err := aladino.MockDefaultEnv(nil, nil)
+	if err != nil {
+		log.Fatalf("mockDefaultEnv failed: %v", err)
+	}
+
+	expr, err := aladino.Parse("3 / 4 > 0.7 && 3 / 4 < 8e-1")
+	if err != nil {
+		log.Fatalf("parse failed: %v", err)
+	}
+
+	gotVal, err := expr.Eval(mockedEnv)
+
+	wantVal := aladino.BuildTrueValue()
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestEval_OnDivOp_WhenDivisionByFloatZero(t *testing.T) {
+	mockedEnv, err := aladino.MockDefaultEnv(nil, nil)
+	if err != nil {
+		log.Fatalf("mockDefaultEnv failed: %v", err)
+	}
+
+	expr, err := aladino.Parse("10 / 0.0")
+	if err != nil {
+		log.Fatalf("parse failed: %v", err)
+	}
+
+	gotVal, err := expr.Eval(mockedEnv)
+
+	assert.Nil(t, gotVal)
+	assert.EqualError(t, err, "eval: division by zero")
+}
+
+func TestEval_OnLessThanOp_WhenStrings(t *testing.T) {
+	lessThanOp := &aladino.LessThanOp{}
+	gotVal := lessThanOp.Eval(aladino.BuildStringValue("apple"), aladino.BuildStringValue("banana"))
+
+	wantVal := aladino.BuildTrueValue()
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestEval_OnMatchOp(t *testing.T) {
+	matchOp := &aladino.MatchOp{}
+	gotVal := matchOp.Eval(aladino.BuildStringValue("fix(lang): typo"), aladino.BuildStringValue(`^(feat|fix)(\(.+\))?: `))
+
+	wantVal := aladino.BuildTrueValue()
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestEval_OnNotMatchOp(t *testing.T) {
+	notMatchOp := &aladino.NotMatchOp{}
+	gotVal := notMatchOp.Eval(aladino.BuildStringValue("WIP: new feature"), aladino.BuildStringValue("WIP"))
+
+	wantVal := aladino.BuildFalseValue()
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestEval_OnMatchOp_WhenRegexIsInvalid(t *testing.T) {
+	mockedEnv, err := aladino.MockDefaultEnv(nil, nil)
+	if err != nil {
+		log.Fatalf("mockDefaultEnv failed: %v", err)
+	}
+
+	expr, err := aladino.Parse(`"fix: typo" =~ $returnStr("^(fix")`)
+	if err != nil {
+		log.Fatalf("parse failed: %v", err)
+	}
+
+	gotVal, err := expr.Eval(mockedEnv)
+
+	assert.Nil(t, gotVal)
+	assert.EqualError(t, err, "eval: invalid regular expression: error parsing regexp: missing closing ): `^(fix`")
+}
+
+func TestEval_OnMatchOp_WhenOperatorRegexIsInvalid(t *testing.T) {
+	matchOp := &aladino.MatchOp{}
+
+	assert.PanicsWithError(t, "eval: invalid regular expression: error parsing regexp: missing closing ): `^(fix`", func() {
+		matchOp.Eval(aladino.BuildStringValue("fix: typo"), aladino.BuildStringValue("^(fix"))
+	})
+}
+
+func TestEval_OnNotMatchExpr_WhenRegexIsInvalid(t *testing.T) {
+	mockedEnv, err := aladino.MockDefaultEnv(nil, nil)
+	if err != nil {
+		log.Fatalf("mockDefaultEnv failed: %v", err)
+	}
+
+	expr, err := aladino.Parse(`"fix: typo" !~ $returnStr("^(fix")`)
+	if err != nil {
+		log.Fatalf("parse failed: %v", err)
+	}
+
+	gotVal, err := expr.Eval(mockedEnv)
+
+	assert.Nil(t, gotVal)
+	assert.ErrorIs(t, err, aladino.ErrInvalidRegex)
+}
+
+func TestEval_OnNotMatchExpr(t *testing.T) {
+	mockedEnv, err := aladino.MockDefaultEnv(nil, nil)
+	if err != nil {
+		log.Fatalf("mockDefaultEnv failed: %v", err)
+	}
+
+	expr, err := aladino.Parse(`"fix: typo" !~ $returnStr("^feat")`)
+	if err != nil {
+		log.Fatalf("parse failed: %v", err)
+	}
+
+	gotVal, err := expr.Eval(mockedEnv)
+
+	wantVal := aladino.BuildTrueValue()
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestEval_OnInOp(t *testing.T) {
+	inOp := &aladino.InOp{}
+	gotVal := inOp.Eval(
+		aladino.BuildStringValue("jane"),
+		aladino.BuildArrayValue([]aladino.Value{aladino.BuildStringValue("john"), aladino.BuildStringValue("jane")}),
+	)
+
+	wantVal := aladino.BuildTrueValue()
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestEval_OnInOp_WhenNotElement(t *testing.T) {
+	inOp := &aladino.InOp{}
+	gotVal := inOp.Eval(aladino.BuildStringValue("mary"), aladino.BuildArrayValue([]aladino.Value{}))
+
+	wantVal := aladino.BuildFalseValue()
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestEval_OnConditional(t *testing.T) {
+	mockedEnv, err := aladino.MockDefaultEnv(nil, nil)
+	if err != nil {
+		log.Fatalf("mockDefaultEnv failed: %v", err)
+	}
+
+	expr, err := aladino.Parse(`if 1 in [2, 3] then "small" else "large"`)
+	if err != nil {
+		log.Fatalf("parse failed: %v", err)
+	}
+
+	gotVal, err := expr.Eval(mockedEnv)
+
+	wantVal := aladino.BuildStringValue("large")
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestEval_OnConditional_OnlyEvaluatesChosenBranch(t *testing.T) {
+	mockedEnv, err := aladino.MockDefaultEnv(nil, nil)
+	if err != nil {
+		log.Fatalf("mockDefaultEnv failed: %v", err)
+	}
+
+	expr, err := aladino.Parse(`if true then 1 else 1 / 0`)
+	if err != nil {
+		log.Fatalf("parse failed: %v", err)
+	}
+
+	gotVal, err := expr.Eval(mockedEnv)
+
+	wantVal := aladino.BuildIntValue(1)
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestEval_OnDivOp_WhenDivisionByZero(t *testing.T) {
+	mockedEnv, err := aladino.MockDefaultEnv(nil, nil)
+	if err != nil {
+		log.Fatalf("mockDefaultEnv failed: %v", err)
+	}
+
+	expr, err := aladino.Parse("10 / $zeroConst()")
+	if err != nil {
+		log.Fatalf("parse failed: %v", err)
+	}
+
+	gotVal, err := expr.Eval(mockedEnv)
+
+	assert.Nil(t, gotVal)
+	assert.EqualError(t, err, "eval: division by zero")
+}
+
+func TestEval_OnModOp_WhenDivisionByZero(t *testing.T) {
+	mockedEnv, err := aladino.MockDefaultEnv(nil, nil)
+	if err != nil {
+		log.Fatalf("mockDefaultEnv failed: %v", err)
+	}
+
+	expr, err := aladino.Parse("10 % 0")
+	if err != nil {
+		log.Fatalf("parse failed: %v", err)
+	}
+
+	gotVal, err := expr.Eval(mockedEnv)
+
+	assert.Nil(t, gotVal)
+	assert.EqualError(t, err, "eval: division by zero")
+}
+
+func TestEval_OnTimeConst(t *testing.T) {
+	mockedEnv, err := aladino.MockDefaultEnv(nil, nil)
+	if err != nil {
+		log.Fatalf("mockDefaultEnv failed: %v", err)
+	}
+
+	expr, err := aladino.Parse("2022-04-05")
+	if err != nil {
+		log.Fatalf("parse failed: %v", err)
+	}
+
+	gotVal, err := expr.Eval(mockedEnv)
+
+	wantVal := aladino.BuildTimeValue(int(time.Date(2022, 4, 5, 0, 0, 0, 0, time.UTC).Unix()))
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestEval_OnDurationConst(t *testing.T) {
+	mockedEnv, err := aladino.MockDefaultEnv(nil, nil)
+	if err != nil {
+		log.Fatalf("mockDefaultEnv failed: %v", err)
+	}
+
+	gotVal, err := aladino.BuildDurationConst(60).Eval(mockedEnv)
+
+	wantVal := aladino.BuildDurationValue(60)
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestEval_OnTimeSubtraction(t *testing.T) {
+	mockedEnv, err := aladino.MockDefaultEnv(nil, nil)
+	if err != nil {
+		log.Fatalf("mockDefaultEnv failed: %v", err)
+	}
+
+	expr, err := aladino.Parse("2022-04-05 - 2022-04-01 == 4d")
+	if err != nil {
+		log.Fatalf("parse failed: %v", err)
+	}
+
+	gotVal, err := expr.Eval(mockedEnv)
+
+	wantVal := aladino.BuildTrueValue()
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestEval_OnTimeComparison(t *testing.T) {
+	mockedEnv, err := aladino.MockDefaultEnv(nil, nil)
+	if err != nil {
+		log.Fatalf("mockDefaultEnv failed: %v", err)
+	}
+
+	expr, err := aladino.Parse("2022-04-05T10:00:00 > 2022-04-05 && 2022-04-01 <= 2022-04-01")
+	if err != nil {
+		log.Fatalf("parse failed: %v", err)
+	}
+
+	gotVal, err := expr.Eval(mockedEnv)
+
+	wantVal := aladino.BuildTrueValue()
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestEval_OnTimePlusDuration(t *testing.T) {
+	mockedEnv, err := aladino.MockDefaultEnv(nil, nil)
+	if err != nil {
+		log.Fatalf("mockDefaultEnv failed: %v", err)
+	}
+
+	expr, err := aladino.Parse("2022-04-01 + 1d == 2022-04-02 && 1w + 2022-04-01 == 2022-04-08")
+	if err != nil {
+		log.Fatalf("parse failed: %v", err)
+	}
+
+	gotVal, err := expr.Eval(mockedEnv)
+
+	wantVal := aladino.BuildTrueValue()
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestEval_OnTimeMinusDuration(t *testing.T) {
+	mockedEnv, err := aladino.MockDefaultEnv(nil, nil)
+	if err != nil {
+		log.Fatalf("mockDefaultEnv failed: %v", err)
+	}
+
+	expr, err := aladino.Parse("2022-04-02 - 12h - 12h == 2022-04-01")
+	if err != nil {
+		log.Fatalf("parse failed: %v", err)
+	}
+
+	gotVal, err := expr.Eval(mockedEnv)
+
+	wantVal := aladino.BuildTrueValue()
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestEval_OnDurationArithmetic(t *testing.T) {
+	mockedEnv, err := aladino.MockDefaultEnv(nil, nil)
+	if err != nil {
+		log.Fatalf("mockDefaultEnv failed: %v", err)
+	}
+
+	expr, err := aladino.Parse("12h + 720m == 1d && 1d - 86399s == 1s")
+	if err != nil {
+		log.Fatalf("parse failed: %v", err)
+	}
+
+	gotVal, err := expr.Eval(mockedEnv)
+
+	wantVal := aladino.BuildTrueValue()
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestEval_OnDurationComparison(t *testing.T) {
+	mockedEnv, err := aladino.MockDefaultEnv(nil, nil)
+	if err != nil {
+		log.Fatalf("mockDefaultEnv failed: %v", err)
+	}
+
+	expr, err := aladino.Parse("3d > 2d + 23h")
+	if err != nil {
+		log.Fatalf("parse failed: %v", err)
+	}
+
+	gotVal, err := expr.Eval(mockedEnv)
+
+	wantVal := aladino.BuildTrueValue()
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestEval_OnRelativeTimeConst(t *testing.T) {
+	mockedEnv, err := aladino.MockDefaultEnv(nil, nil)
+	if err != nil {
+		log.Fatalf("mockDefaultEnv failed: %v", err)
+	}
+
+	expr, err := aladino.Parse("3 days ago")
+	if err != nil {
+		log.Fatalf("parse failed: %v", err)
+	}
+
+	gotVal, err := expr.Eval(mockedEnv)
+
+	wantVal := aladino.BuildTimeValue(int(aladino.DefaultMockNow.AddDate(0, 0, -3).Unix()))
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantVal, gotVal)
+}
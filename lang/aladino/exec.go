@@ -4,10 +4,14 @@
 
 package aladino
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 type ExecExpr interface {
 	exec(env Env) error
+	describe(env Env) (string, error)
 }
 
 func TypeCheckExec(env Env, expr Expr) (ExecExpr, error) {
@@ -24,13 +28,14 @@ func TypeCheckExec(env Env, expr Expr) (ExecExpr, error) {
 	return nil, fmt.Errorf("typecheckexec: %v", expr.Kind())
 }
 
-func (fc *FunctionCall) exec(env Env) error {
+// action returns the built-in action that is called along with its arguments.
+func (fc *FunctionCall) action(env Env) (*BuiltInAction, []Value, error) {
 	args := make([]Value, len(fc.arguments))
 	for i, elem := range fc.arguments {
 		value, err := elem.Eval(env)
 
 		if err != nil {
-			return err
+			return nil, nil, err
 		}
 
 		args[i] = value
@@ -38,7 +43,21 @@ func (fc *FunctionCall) exec(env Env) error {
 
 	action, ok := env.GetBuiltIns().Actions[fc.name.ident]
 	if !ok {
-		return fmt.Errorf("exec: %v not found. are you sure this is a built-in function?", fc.name.ident)
+		return nil, nil, fmt.Errorf("exec: %v not found. are you sure this is a built-in function?", fc.name.ident)
+	}
+
+	args, err := bindArguments(fc, action.Type, args)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return action, args, nil
+}
+
+func (fc *FunctionCall) exec(env Env) error {
+	action, args, err := fc.action(env)
+	if err != nil {
+		return err
 	}
 
 	env.GetCollector().Collect("Ran Builtin", map[string]interface{}{
@@ -48,3 +67,23 @@ func (fc *FunctionCall) exec(env Env) error {
 
 	return action.Code(env, args)
 }
+
+// describe returns the side effect of the action without running it.
+// Actions without a description are described by their call (e.g. run $close()).
+func (fc *FunctionCall) describe(env Env) (string, error) {
+	action, args, err := fc.action(env)
+	if err != nil {
+		return "", err
+	}
+
+	if action.Describe == nil {
+		formattedArgs := make([]string, len(args))
+		for i, arg := range args {
+			formattedArgs[i] = formatValue(arg)
+		}
+
+		return fmt.Sprintf("run $%v(%v)", fc.name.ident, strings.Join(formattedArgs, ", ")), nil
+	}
+
+	return action.Describe(env, args), nil
+}
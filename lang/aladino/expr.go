@@ -6,12 +6,10 @@ package aladino
 
 import (
 	"fmt"
-	"log"
 	"regexp"
 	"strconv"
+	"strings"
 	"time"
-
-	"github.com/reviewpad/reviewpad/v3/utils/report"
 )
 
 type Expr interface {
@@ -24,15 +22,20 @@ type Expr interface {
 const (
 	BOOL_CONST          string = "BoolConst"
 	INT_CONST           string = "IntConst"
+	FLOAT_CONST         string = "FloatConst"
 	STRING_CONST        string = "StringConst"
 	TIME_CONST          string = "TimeConst"
+	DURATION_CONST      string = "DurationConst"
+	RELATIVE_TIME_CONST string = "RelativeTimeConst"
 	VARIABLE_CONST      string = "Variable"
 	UNARY_OP_CONST      string = "UnaryOp"
 	BINARY_OP_CONST     string = "BinaryOp"
 	FUNCTION_CALL_CONST string = "FunctionCall"
 	LAMBDA_CONST        string = "Lambda"
 	TYPED_EXPR          string = "TypedExpr"
+	NAMED_ARG           string = "NamedArg"
 	ARRAY_CONST         string = "Array"
+	CONDITIONAL_CONST   string = "Conditional"
 	NOT_OP              string = "!"
 	EQ_OP               string = "=="
 	NEQ_OP              string = "!="
@@ -42,6 +45,15 @@ const (
 	LESS_EQ_THAN_OP     string = "<="
 	GREATER_THAN_OP     string = ">"
 	GREATER_EQ_THAN_OP  string = ">="
+	NEG_OP              string = "-"
+	ADD_OP              string = "+"
+	SUB_OP              string = "-"
+	MUL_OP              string = "*"
+	DIV_OP              string = "/"
+	MOD_OP              string = "%"
+	MATCH_OP            string = "=~"
+	NOT_MATCH_OP        string = "!~"
+	IN_OP               string = "in"
 )
 
 type UnaryOperator interface {
@@ -50,10 +62,13 @@ type UnaryOperator interface {
 }
 
 type NotOp struct{}
+type NegOp struct{}
 
 func notOperator() *NotOp { return &NotOp{} }
+func negOperator() *NegOp { return &NegOp{} }
 
 func (op *NotOp) getOperator() string { return NOT_OP }
+func (op *NegOp) getOperator() string { return NEG_OP }
 
 type BinaryOperator interface {
 	getOperator() string
@@ -68,6 +83,14 @@ type LessThanOp struct{}
 type LessEqThanOp struct{}
 type GreaterThanOp struct{}
 type GreaterEqThanOp struct{}
+type AddOp struct{}
+type SubOp struct{}
+type MulOp struct{}
+type DivOp struct{}
+type ModOp struct{}
+type MatchOp struct{ constPattern }
+type NotMatchOp struct{ constPattern }
+type InOp struct{}
 
 func eqOperator() *EqOp                       { return &EqOp{} }
 func neqOperator() *NeqOp                     { return &NeqOp{} }
@@ -77,6 +100,14 @@ func lessThanOperator() *LessThanOp           { return &LessThanOp{} }
 func lessEqThanOperator() *LessEqThanOp       { return &LessEqThanOp{} }
 func greaterThanOperator() *GreaterThanOp     { return &GreaterThanOp{} }
 func greaterEqThanOperator() *GreaterEqThanOp { return &GreaterEqThanOp{} }
+func addOperator() *AddOp                     { return &AddOp{} }
+func subOperator() *SubOp                     { return &SubOp{} }
+func mulOperator() *MulOp                     { return &MulOp{} }
+func divOperator() *DivOp                     { return &DivOp{} }
+func modOperator() *ModOp                     { return &ModOp{} }
+func matchOperator() *MatchOp                 { return &MatchOp{} }
+func notMatchOperator() *NotMatchOp           { return &NotMatchOp{} }
+func inOperator() *InOp                       { return &InOp{} }
 
 func (op *EqOp) getOperator() string            { return EQ_OP }
 func (op *NeqOp) getOperator() string           { return NEQ_OP }
@@ -86,6 +117,24 @@ func (op *LessThanOp) getOperator() string      { return LESS_THAN_OP }
 func (op *LessEqThanOp) getOperator() string    { return LESS_EQ_THAN_OP }
 func (op *GreaterThanOp) getOperator() string   { return GREATER_THAN_OP }
 func (op *GreaterEqThanOp) getOperator() string { return GREATER_EQ_THAN_OP }
+func (op *AddOp) getOperator() string           { return ADD_OP }
+func (op *SubOp) getOperator() string           { return SUB_OP }
+func (op *MulOp) getOperator() string           { return MUL_OP }
+func (op *DivOp) getOperator() string           { return DIV_OP }
+func (op *ModOp) getOperator() string           { return MOD_OP }
+func (op *MatchOp) getOperator() string         { return MATCH_OP }
+func (op *NotMatchOp) getOperator() string      { return NOT_MATCH_OP }
+func (op *InOp) getOperator() string            { return IN_OP }
+
+// constPattern is the regular expression of a =~ or !~ operation whose right operand is a constant.
+// It is compiled once when the operation is type checked instead of every time it is evaluated.
+type constPattern struct {
+	pattern *regexp.Regexp
+}
+
+func (c *constPattern) getPattern() *regexp.Regexp { return c.pattern }
+
+func (c *constPattern) setPattern(pattern *regexp.Regexp) { c.pattern = pattern }
 
 type BoolConst struct {
 	value bool
@@ -99,6 +148,10 @@ func (b *BoolConst) Kind() string {
 	return BOOL_CONST
 }
 
+func (b *BoolConst) Value() bool {
+	return b.value
+}
+
 func (thisBool *BoolConst) equals(other Expr) bool {
 	if thisBool.Kind() != other.Kind() {
 		return false
@@ -119,6 +172,10 @@ func (c *StringConst) Kind() string {
 	return STRING_CONST
 }
 
+func (c *StringConst) Value() string {
+	return c.value
+}
+
 func (thisString *StringConst) equals(other Expr) bool {
 	if thisString.Kind() != other.Kind() {
 		return false
@@ -139,6 +196,10 @@ func (i *IntConst) Kind() string {
 	return INT_CONST
 }
 
+func (i *IntConst) Value() int {
+	return i.value
+}
+
 func (thisInt *IntConst) equals(other Expr) bool {
 	if thisInt.Kind() != other.Kind() {
 		return false
@@ -147,100 +208,166 @@ func (thisInt *IntConst) equals(other Expr) bool {
 	return thisInt.value == other.(*IntConst).value
 }
 
-func BuildRelativeTimeConst(val string) *IntConst {
-	now := time.Now()
+type FloatConst struct {
+	value float64
+}
 
-	timeUnitRegex := regexp.MustCompile(`year|month|week|day|hour|minute`)
-	timeUnit := timeUnitRegex.FindString(val)
+func BuildFloatConst(val float64) *FloatConst {
+	return &FloatConst{val}
+}
 
-	timeValueRegex := regexp.MustCompile(`^[0-9]+`)
-	timeValue, err := strconv.Atoi(timeValueRegex.FindString(val))
-	if err != nil {
-		log.Fatalf(report.Error(err.Error()))
+func (f *FloatConst) Kind() string {
+	return FLOAT_CONST
+}
+
+func (f *FloatConst) Value() float64 {
+	return f.value
+}
+
+func (thisFloat *FloatConst) equals(other Expr) bool {
+	if thisFloat.Kind() != other.Kind() {
+		return false
 	}
 
-	switch timeUnit {
-	case "year":
-		var a = now.AddDate(-timeValue, 0, 0)
-		a.UnixMilli()
-		return &IntConst{
-			value: int(now.AddDate(-timeValue, 0, 0).Unix()),
-		}
-	case "month":
-		return &IntConst{
-			value: int(now.AddDate(0, -timeValue, 0).Unix()),
-		}
-	case "day":
-		return &IntConst{
-			value: int(now.AddDate(0, 0, -timeValue).Unix()),
-		}
-	case "week":
-		week := time.Hour * 24 * 7
-		return &IntConst{
-			value: int(now.Add(-week * time.Duration(timeValue)).Unix()),
-		}
-	case "hour":
-		return &IntConst{
-			value: int(now.Add(-time.Hour * time.Duration(timeValue)).Unix()),
-		}
-	case "minute":
-		return &IntConst{
-			value: int(now.Add(-time.Minute * time.Duration(timeValue)).Unix()),
-		}
+	return thisFloat.value == other.(*FloatConst).value
+}
+
+// TimeConst is an instant in time as a Unix time in seconds
+type TimeConst struct {
+	value int
+}
+
+func (t *TimeConst) Kind() string {
+	return TIME_CONST
+}
+
+// Value returns the instant as a Unix time in seconds.
+func (t *TimeConst) Value() int {
+	return t.value
+}
+
+func (thisTime *TimeConst) equals(other Expr) bool {
+	if thisTime.Kind() != other.Kind() {
+		return false
 	}
 
-	log.Fatalf(report.Error("Unknown time unit %v", timeUnit))
-	return &IntConst{}
+	return thisTime.value == other.(*TimeConst).value
+}
+
+// DurationConst is a duration in seconds
+type DurationConst struct {
+	value int
 }
 
-func BuildTimeConst(val string) *IntConst {
-	dateValueRegex := regexp.MustCompile(`^(\d{4})-?(\d{2})-?(\d{2})`)
-	dateValue := dateValueRegex.FindSubmatch([]byte(val))
+func BuildDurationConst(val int) *DurationConst {
+	return &DurationConst{val}
+}
 
-	year, err := strconv.Atoi(string(dateValue[1][:]))
-	if err != nil {
-		log.Fatalf(report.Error("Error converting year value %q", dateValue[1]))
+func (d *DurationConst) Kind() string {
+	return DURATION_CONST
+}
+
+// Value returns the duration in seconds.
+func (d *DurationConst) Value() int {
+	return d.value
+}
+
+func (thisDuration *DurationConst) equals(other Expr) bool {
+	if thisDuration.Kind() != other.Kind() {
+		return false
 	}
 
-	month, err := strconv.Atoi(string(dateValue[2][:]))
-	if err != nil {
-		log.Fatalf(report.Error("Error converting month value %q", dateValue[2]))
+	return thisDuration.value == other.(*DurationConst).value
+}
+
+// RelativeTimeConst is an instant in time relative to the clock of the environment (e.g. 3 days ago).
+// It is only resolved to an actual time when it is evaluated.
+type RelativeTimeConst struct {
+	amount int
+	unit   string
+}
+
+var relativeTimeRegex = regexp.MustCompile(`^([0-9]+)\s(year|month|week|day|hour|minute)s?\sago$`)
+
+func BuildRelativeTimeConst(val string) (*RelativeTimeConst, error) {
+	relativeTime := relativeTimeRegex.FindStringSubmatch(val)
+	if relativeTime == nil {
+		return nil, fmt.Errorf("invalid relative timestamp %v", val)
 	}
 
-	day, err := strconv.Atoi(string(dateValue[3][:]))
+	amount, err := strconv.Atoi(relativeTime[1])
 	if err != nil {
-		log.Fatalf(report.Error("Error converting day value %q", dateValue[3]))
+		return nil, fmt.Errorf("invalid relative timestamp %v", val)
 	}
 
-	timeValueRegex := regexp.MustCompile(`T(\d{2}):(\d{2}):(\d{2})$`)
-	timeValue := timeValueRegex.FindSubmatch([]byte(val))
+	return &RelativeTimeConst{amount, relativeTime[2]}, nil
+}
 
-	hour := 0
-	minute := 0
-	second := 0
+func (r *RelativeTimeConst) Kind() string {
+	return RELATIVE_TIME_CONST
+}
 
-	if len(timeValue) > 0 {
-		hour, err = strconv.Atoi(string(timeValue[1][:]))
-		if err != nil {
-			log.Fatalf(report.Error("Error converting hour value %q", timeValue[1]))
-		}
+func (r *RelativeTimeConst) Amount() int {
+	return r.amount
+}
 
-		minute, err = strconv.Atoi(string(timeValue[2][:]))
-		if err != nil {
-			log.Fatalf(report.Error("Error converting minute value %q", timeValue[2]))
-		}
+// Unit returns the singular unit of the relative time (e.g. day).
+func (r *RelativeTimeConst) Unit() string {
+	return r.unit
+}
 
-		second, err = strconv.Atoi(string(timeValue[3][:]))
-		if err != nil {
-			log.Fatalf(report.Error("Error converting second value %q", timeValue[3]))
-		}
+func (thisRelativeTime *RelativeTimeConst) equals(other Expr) bool {
+	if thisRelativeTime.Kind() != other.Kind() {
+		return false
 	}
 
-	return &IntConst{
-		value: int(time.Date(year, time.Month(month), day, hour, minute, second, 0, time.UTC).Unix()),
+	otherRelativeTime := other.(*RelativeTimeConst)
+
+	return thisRelativeTime.amount == otherRelativeTime.amount && thisRelativeTime.unit == otherRelativeTime.unit
+}
+
+// before returns the instant that is the relative time before now.
+func (r *RelativeTimeConst) before(now time.Time) time.Time {
+	switch r.unit {
+	case "year":
+		return now.AddDate(-r.amount, 0, 0)
+	case "month":
+		return now.AddDate(0, -r.amount, 0)
+	case "week":
+		return now.AddDate(0, 0, -7*r.amount)
+	case "day":
+		return now.AddDate(0, 0, -r.amount)
+	case "hour":
+		return now.Add(-time.Hour * time.Duration(r.amount))
+	default:
+		return now.Add(-time.Minute * time.Duration(r.amount))
 	}
 }
 
+// Allowed formats of timestamps once the dashes in the date are removed
+var timestampLayouts = []string{
+	"20060102",
+	"20060102T15:04:05",
+}
+
+func BuildTimeConst(val string) (*TimeConst, error) {
+	date, clock, hasClock := strings.Cut(val, "T")
+
+	timestamp := strings.ReplaceAll(date, "-", "")
+	if hasClock {
+		timestamp = fmt.Sprintf("%vT%v", timestamp, clock)
+	}
+
+	for _, layout := range timestampLayouts {
+		timeValue, err := time.Parse(layout, timestamp)
+		if err == nil {
+			return &TimeConst{int(timeValue.Unix())}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("invalid timestamp %v", val)
+}
+
 type Variable struct {
 	ident string
 }
@@ -253,6 +380,10 @@ func (v *Variable) Kind() string {
 	return VARIABLE_CONST
 }
 
+func (v *Variable) Ident() string {
+	return v.ident
+}
+
 func (thisVariable *Variable) equals(other Expr) bool {
 	if thisVariable.Kind() != other.Kind() {
 		return false
@@ -271,11 +402,20 @@ func BuildUnaryOp(op UnaryOperator, expr Expr) *UnaryOp {
 }
 
 func BuildNotOp(expr Expr) *UnaryOp { return BuildUnaryOp(notOperator(), expr) }
+func BuildNegOp(expr Expr) *UnaryOp { return BuildUnaryOp(negOperator(), expr) }
 
 func (b *UnaryOp) Kind() string {
 	return UNARY_OP_CONST
 }
 
+func (b *UnaryOp) Operator() string {
+	return b.op.getOperator()
+}
+
+func (b *UnaryOp) Operand() Expr {
+	return b.expr
+}
+
 func (thisUnaryOp *UnaryOp) equals(other Expr) bool {
 	if thisUnaryOp.Kind() != other.Kind() {
 		return false
@@ -314,6 +454,18 @@ func BuildGreaterThanOp(lhs Expr, rhs Expr) *BinaryOp {
 func BuildGreaterEqThanOp(lhs Expr, rhs Expr) *BinaryOp {
 	return BuildBinaryOp(lhs, greaterEqThanOperator(), rhs)
 }
+func BuildAddOp(lhs Expr, rhs Expr) *BinaryOp { return BuildBinaryOp(lhs, addOperator(), rhs) }
+func BuildSubOp(lhs Expr, rhs Expr) *BinaryOp { return BuildBinaryOp(lhs, subOperator(), rhs) }
+func BuildMulOp(lhs Expr, rhs Expr) *BinaryOp { return BuildBinaryOp(lhs, mulOperator(), rhs) }
+func BuildDivOp(lhs Expr, rhs Expr) *BinaryOp { return BuildBinaryOp(lhs, divOperator(), rhs) }
+func BuildModOp(lhs Expr, rhs Expr) *BinaryOp { return BuildBinaryOp(lhs, modOperator(), rhs) }
+func BuildMatchOp(lhs Expr, rhs Expr) *BinaryOp {
+	return BuildBinaryOp(lhs, matchOperator(), rhs)
+}
+func BuildNotMatchOp(lhs Expr, rhs Expr) *BinaryOp {
+	return BuildBinaryOp(lhs, notMatchOperator(), rhs)
+}
+func BuildInOp(lhs Expr, rhs Expr) *BinaryOp { return BuildBinaryOp(lhs, inOperator(), rhs) }
 
 func BuildCmpOp(lhs Expr, op string, rhs Expr) Expr {
 	switch op {
@@ -335,19 +487,103 @@ func (b *BinaryOp) Kind() string {
 	return BINARY_OP_CONST
 }
 
+func (b *BinaryOp) Operator() string {
+	return b.op.getOperator()
+}
+
+func (b *BinaryOp) Lhs() Expr {
+	return b.lhs
+}
+
+func (b *BinaryOp) Rhs() Expr {
+	return b.rhs
+}
+
 func (thisBinOp *BinaryOp) equals(other Expr) bool {
 	if thisBinOp.Kind() != other.Kind() {
 		return false
 	}
 
 	otherBinaryOp := other.(*BinaryOp)
-	checkOp := thisBinOp.op == otherBinaryOp.op
+	checkOp := thisBinOp.op.getOperator() == otherBinaryOp.op.getOperator()
 	lhsCheck := thisBinOp.lhs.equals(otherBinaryOp.lhs)
 	rhsCheck := thisBinOp.rhs.equals(otherBinaryOp.rhs)
 
 	return checkOp && lhsCheck && rhsCheck
 }
 
+// Conditional is an expression of the form: if condition then thenExpr else elseExpr
+type Conditional struct {
+	condition Expr
+	thenExpr  Expr
+	elseExpr  Expr
+}
+
+func BuildConditional(condition, thenExpr, elseExpr Expr) *Conditional {
+	return &Conditional{condition, thenExpr, elseExpr}
+}
+
+func (c *Conditional) Kind() string {
+	return CONDITIONAL_CONST
+}
+
+func (c *Conditional) Condition() Expr {
+	return c.condition
+}
+
+func (c *Conditional) Then() Expr {
+	return c.thenExpr
+}
+
+func (c *Conditional) Else() Expr {
+	return c.elseExpr
+}
+
+func (thisConditional *Conditional) equals(other Expr) bool {
+	if thisConditional.Kind() != other.Kind() {
+		return false
+	}
+
+	otherConditional := other.(*Conditional)
+	conditionCheck := thisConditional.condition.equals(otherConditional.condition)
+	thenCheck := thisConditional.thenExpr.equals(otherConditional.thenExpr)
+	elseCheck := thisConditional.elseExpr.equals(otherConditional.elseExpr)
+
+	return conditionCheck && thenCheck && elseCheck
+}
+
+// NamedArg is an argument given by the name of the parameter in a call (e.g. total: 2)
+type NamedArg struct {
+	name  string
+	value Expr
+}
+
+func BuildNamedArg(name string, value Expr) *NamedArg {
+	return &NamedArg{name, value}
+}
+
+func (na *NamedArg) Kind() string {
+	return NAMED_ARG
+}
+
+func (na *NamedArg) Name() string {
+	return na.name
+}
+
+func (na *NamedArg) Value() Expr {
+	return na.value
+}
+
+func (thisNamedArg *NamedArg) equals(other Expr) bool {
+	if thisNamedArg.Kind() != other.Kind() {
+		return false
+	}
+
+	otherNamedArg := other.(*NamedArg)
+
+	return thisNamedArg.name == otherNamedArg.name && thisNamedArg.value.equals(otherNamedArg.value)
+}
+
 type FunctionCall struct {
 	name      *Variable
 	arguments []Expr
@@ -361,6 +597,15 @@ func (fc *FunctionCall) Kind() string {
 	return FUNCTION_CALL_CONST
 }
 
+// Name returns the name of the called function without the $ (e.g. addLabel).
+func (fc *FunctionCall) Name() string {
+	return fc.name.ident
+}
+
+func (fc *FunctionCall) Arguments() []Expr {
+	return copyExprs(fc.arguments)
+}
+
 func (thisFnCall *FunctionCall) equals(other Expr) bool {
 	if thisFnCall.Kind() != other.Kind() {
 		return false
@@ -385,6 +630,10 @@ func (a *Array) Kind() string {
 	return ARRAY_CONST
 }
 
+func (a *Array) Elems() []Expr {
+	return copyExprs(a.elems)
+}
+
 func (thisArray *Array) equals(other Expr) bool {
 	if thisArray.Kind() != other.Kind() {
 		return false
@@ -395,6 +644,11 @@ func (thisArray *Array) equals(other Expr) bool {
 	return EqualList(thisArray.elems, otherArray.elems)
 }
 
+// copyExprs returns a copy of exprs so that the ASTs, which are shared once compiled, cannot be modified.
+func copyExprs(exprs []Expr) []Expr {
+	return append([]Expr{}, exprs...)
+}
+
 func EqualList(left []Expr, right []Expr) bool {
 	if len(left) != len(right) {
 		return false
@@ -423,6 +677,14 @@ func (te *TypedExpr) Kind() string {
 	return TYPED_EXPR
 }
 
+func (te *TypedExpr) Expr() Expr {
+	return te.expr
+}
+
+func (te *TypedExpr) Type() Type {
+	return te.typeOf
+}
+
 func (te *TypedExpr) equals(other Expr) bool {
 	if te.Kind() != other.Kind() {
 		return false
@@ -447,6 +709,15 @@ func (l *Lambda) Kind() string {
 	return LAMBDA_CONST
 }
 
+// Parameters returns the typed variables (TypedExpr) of the lambda.
+func (l *Lambda) Parameters() []Expr {
+	return copyExprs(l.parameters)
+}
+
+func (l *Lambda) Body() Expr {
+	return l.body
+}
+
 func (thisLambda *Lambda) equals(other Expr) bool {
 	if thisLambda.Kind() != other.Kind() {
 		return false
@@ -130,6 +130,69 @@ func TestGetOperator_WhenGreaterEqThanOp(t *testing.T) {
 	assert.Equal(t, wantVal, gotVal)
 }
 
+func TestGetOperator_WhenNegOp(t *testing.T) {
+	wantVal := NEG_OP
+	gotVal := negOperator().getOperator()
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestGetOperator_WhenAddOp(t *testing.T) {
+	wantVal := ADD_OP
+	gotVal := addOperator().getOperator()
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestGetOperator_WhenSubOp(t *testing.T) {
+	wantVal := SUB_OP
+	gotVal := subOperator().getOperator()
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestGetOperator_WhenMulOp(t *testing.T) {
+	wantVal := MUL_OP
+	gotVal := mulOperator().getOperator()
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestGetOperator_WhenDivOp(t *testing.T) {
+	wantVal := DIV_OP
+	gotVal := divOperator().getOperator()
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestGetOperator_WhenModOp(t *testing.T) {
+	wantVal := MOD_OP
+	gotVal := modOperator().getOperator()
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestGetOperator_WhenMatchOp(t *testing.T) {
+	wantVal := MATCH_OP
+	gotVal := matchOperator().getOperator()
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestGetOperator_WhenNotMatchOp(t *testing.T) {
+	wantVal := NOT_MATCH_OP
+	gotVal := notMatchOperator().getOperator()
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestGetOperator_WhenInOp(t *testing.T) {
+	wantVal := IN_OP
+	gotVal := inOperator().getOperator()
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
 func TestBuildBoolConst(t *testing.T) {
 	wantVal := &BoolConst{true}
 	gotVal := BuildBoolConst(true)
@@ -235,101 +298,273 @@ func TestIntConstEquals_WhenEqual(t *testing.T) {
 	assert.True(t, intConst.equals(otherConst))
 }
 
+func TestBuildFloatConst(t *testing.T) {
+	wantVal := &FloatConst{0.5}
+	gotVal := BuildFloatConst(0.5)
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestFloatConstKind(t *testing.T) {
+	wantVal := FLOAT_CONST
+	gotVal := BuildFloatConst(0.5).Kind()
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestFloatConstEquals_WhenDiffKinds(t *testing.T) {
+	floatConst := BuildFloatConst(1)
+	otherConst := BuildIntConst(1)
+
+	assert.False(t, floatConst.equals(otherConst))
+}
+
+func TestFloatConstEquals_WhenEqual(t *testing.T) {
+	floatConst := BuildFloatConst(0.5)
+	otherConst := BuildFloatConst(0.5)
+
+	assert.True(t, floatConst.equals(otherConst))
+}
+
+func TestTimeConstKind(t *testing.T) {
+	wantVal := TIME_CONST
+	gotVal := (&TimeConst{0}).Kind()
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestTimeConstEquals_WhenDiffKinds(t *testing.T) {
+	timeConst := &TimeConst{0}
+	otherConst := BuildIntConst(0)
+
+	assert.False(t, timeConst.equals(otherConst))
+}
+
+func TestTimeConstEquals_WhenDiffValues(t *testing.T) {
+	timeConst := &TimeConst{0}
+	otherConst := &TimeConst{1}
+
+	assert.False(t, timeConst.equals(otherConst))
+}
+
+func TestTimeConstEquals_WhenEqual(t *testing.T) {
+	timeConst := &TimeConst{0}
+	otherConst := &TimeConst{0}
+
+	assert.True(t, timeConst.equals(otherConst))
+}
+
+func TestBuildDurationConst(t *testing.T) {
+	wantVal := &DurationConst{60}
+	gotVal := BuildDurationConst(60)
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestDurationConstKind(t *testing.T) {
+	wantVal := DURATION_CONST
+	gotVal := BuildDurationConst(60).Kind()
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestDurationConstEquals_WhenDiffKinds(t *testing.T) {
+	durationConst := BuildDurationConst(60)
+	otherConst := BuildIntConst(60)
+
+	assert.False(t, durationConst.equals(otherConst))
+}
+
+func TestDurationConstEquals_WhenDiffValues(t *testing.T) {
+	durationConst := BuildDurationConst(60)
+	otherConst := BuildDurationConst(30)
+
+	assert.False(t, durationConst.equals(otherConst))
+}
+
+func TestDurationConstEquals_WhenEqual(t *testing.T) {
+	durationConst := BuildDurationConst(60)
+	otherConst := BuildDurationConst(60)
+
+	assert.True(t, durationConst.equals(otherConst))
+}
+
 func TestBuildRelativeTimeConst_WhenTimeUnitIsYear(t *testing.T) {
-	now := time.Now()
-	val := "1 year ago"
-	timeValue := 1
+	wantVal := &RelativeTimeConst{2, "year"}
 
-	wantVal := &IntConst{
-		value: int(now.AddDate(-timeValue, 0, 0).Unix()),
-	}
+	gotVal, err := BuildRelativeTimeConst("2 years ago")
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestRelativeTimeConstBefore_WhenTimeUnitIsYear(t *testing.T) {
+	now := time.Date(2022, 4, 5, 10, 0, 0, 0, time.UTC)
 
-	gotVal := BuildRelativeTimeConst(val)
+	wantVal := now.AddDate(-2, 0, 0)
+	gotVal := (&RelativeTimeConst{2, "year"}).before(now)
 
 	assert.Equal(t, wantVal, gotVal)
 }
 
 func TestBuildRelativeTimeConst_WhenTimeUnitIsMonth(t *testing.T) {
-	now := time.Now()
-	val := "1 month ago"
-	timeValue := 1
+	wantVal := &RelativeTimeConst{2, "month"}
 
-	wantVal := &IntConst{
-		value: int(now.AddDate(0, -timeValue, 0).Unix()),
-	}
-	gotVal := BuildRelativeTimeConst(val)
+	gotVal, err := BuildRelativeTimeConst("2 months ago")
 
+	assert.Nil(t, err)
 	assert.Equal(t, wantVal, gotVal)
 }
 
-func TestBuildRelativeTimeConst_WhenTimeUnitIsDay(t *testing.T) {
-	now := time.Now()
-	val := "1 day ago"
-	timeValue := 1
-
-	wantVal := &IntConst{
-		value: int(now.AddDate(0, 0, -timeValue).Unix()),
-	}
+func TestRelativeTimeConstBefore_WhenTimeUnitIsMonth(t *testing.T) {
+	now := time.Date(2022, 4, 5, 10, 0, 0, 0, time.UTC)
 
-	gotVal := BuildRelativeTimeConst(val)
+	wantVal := now.AddDate(0, -2, 0)
+	gotVal := (&RelativeTimeConst{2, "month"}).before(now)
 
 	assert.Equal(t, wantVal, gotVal)
 }
 
 func TestBuildRelativeTimeConst_WhenTimeUnitIsWeek(t *testing.T) {
-	now := time.Now()
-	val := "1 week ago"
-	timeValue := 1
+	wantVal := &RelativeTimeConst{2, "week"}
 
-	wantVal := &IntConst{
-		value: int(now.Add(-(time.Hour * 24 * 7) * time.Duration(timeValue)).Unix()),
-	}
+	gotVal, err := BuildRelativeTimeConst("2 weeks ago")
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestRelativeTimeConstBefore_WhenTimeUnitIsWeek(t *testing.T) {
+	now := time.Date(2022, 4, 5, 10, 0, 0, 0, time.UTC)
+
+	wantVal := now.AddDate(0, 0, -14)
+	gotVal := (&RelativeTimeConst{2, "week"}).before(now)
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestBuildRelativeTimeConst_WhenTimeUnitIsDay(t *testing.T) {
+	wantVal := &RelativeTimeConst{2, "day"}
+
+	gotVal, err := BuildRelativeTimeConst("2 days ago")
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantVal, gotVal)
+}
 
-	gotVal := BuildRelativeTimeConst(val)
+func TestRelativeTimeConstBefore_WhenTimeUnitIsDay(t *testing.T) {
+	now := time.Date(2022, 4, 5, 10, 0, 0, 0, time.UTC)
+
+	wantVal := now.AddDate(0, 0, -2)
+	gotVal := (&RelativeTimeConst{2, "day"}).before(now)
 
 	assert.Equal(t, wantVal, gotVal)
 }
 
 func TestBuildRelativeTimeConst_WhenTimeUnitIsHour(t *testing.T) {
-	now := time.Now()
-	val := "1 hour ago"
-	timeValue := 1
+	wantVal := &RelativeTimeConst{2, "hour"}
 
-	wantVal := &IntConst{
-		value: int(now.Add(-time.Hour * time.Duration(timeValue)).Unix()),
-	}
+	gotVal, err := BuildRelativeTimeConst("2 hours ago")
 
-	gotVal := BuildRelativeTimeConst(val)
+	assert.Nil(t, err)
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestRelativeTimeConstBefore_WhenTimeUnitIsHour(t *testing.T) {
+	now := time.Date(2022, 4, 5, 10, 0, 0, 0, time.UTC)
+
+	wantVal := now.Add(-2 * time.Hour)
+	gotVal := (&RelativeTimeConst{2, "hour"}).before(now)
 
 	assert.Equal(t, wantVal, gotVal)
 }
 
 func TestBuildRelativeTimeConst_WhenTimeUnitIsMinute(t *testing.T) {
-	now := time.Now()
-	val := "1 minute ago"
-	timeValue := 1
+	wantVal := &RelativeTimeConst{2, "minute"}
 
-	wantVal := &IntConst{
-		value: int(now.Add(-time.Minute * time.Duration(timeValue)).Unix()),
-	}
+	gotVal, err := BuildRelativeTimeConst("2 minutes ago")
 
-	gotVal := BuildRelativeTimeConst(val)
+	assert.Nil(t, err)
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestRelativeTimeConstBefore_WhenTimeUnitIsMinute(t *testing.T) {
+	now := time.Date(2022, 4, 5, 10, 0, 0, 0, time.UTC)
+
+	wantVal := now.Add(-2 * time.Minute)
+	gotVal := (&RelativeTimeConst{2, "minute"}).before(now)
 
 	assert.Equal(t, wantVal, gotVal)
 }
 
+func TestBuildRelativeTimeConst_WhenInvalid(t *testing.T) {
+	gotVal, err := BuildRelativeTimeConst("2 decades ago")
+
+	assert.Nil(t, gotVal)
+	assert.EqualError(t, err, "invalid relative timestamp 2 decades ago")
+}
+
+func TestRelativeTimeConstKind(t *testing.T) {
+	wantVal := RELATIVE_TIME_CONST
+	gotVal := (&RelativeTimeConst{2, "day"}).Kind()
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestRelativeTimeConstEquals_WhenDiffKinds(t *testing.T) {
+	relativeTimeConst := &RelativeTimeConst{2, "day"}
+	otherConst := &TimeConst{2}
+
+	assert.False(t, relativeTimeConst.equals(otherConst))
+}
+
+func TestRelativeTimeConstEquals_WhenDiffValues(t *testing.T) {
+	relativeTimeConst := &RelativeTimeConst{2, "day"}
+	otherConst := &RelativeTimeConst{2, "week"}
+
+	assert.False(t, relativeTimeConst.equals(otherConst))
+}
+
+func TestRelativeTimeConstEquals_WhenEqual(t *testing.T) {
+	relativeTimeConst := &RelativeTimeConst{2, "day"}
+	otherConst := &RelativeTimeConst{2, "day"}
+
+	assert.True(t, relativeTimeConst.equals(otherConst))
+}
+
 func TestBuildTimeConst(t *testing.T) {
 	val := "2019-10-12T07:50:52"
 
-	wantVal := &IntConst{
+	wantVal := &TimeConst{
 		value: int(time.Date(2019, time.Month(10), 12, 7, 50, 52, 0, time.UTC).Unix()),
 	}
 
-	gotVal := BuildTimeConst(val)
+	gotVal, err := BuildTimeConst(val)
 
+	assert.Nil(t, err)
 	assert.Equal(t, wantVal, gotVal)
 }
 
+func TestBuildTimeConst_WhenDateHasNoDashes(t *testing.T) {
+	val := "20191012"
+
+	wantVal := &TimeConst{
+		value: int(time.Date(2019, time.Month(10), 12, 0, 0, 0, 0, time.UTC).Unix()),
+	}
+
+	gotVal, err := BuildTimeConst(val)
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestBuildTimeConst_WhenInvalid(t *testing.T) {
+	gotVal, err := BuildTimeConst("2019-13-12")
+
+	assert.Nil(t, gotVal)
+	assert.EqualError(t, err, "invalid timestamp 2019-13-12")
+}
+
 func TestBuildVariable(t *testing.T) {
 	wantVal := &Variable{"Test"}
 	gotVal := BuildVariable("Test")
@@ -470,6 +705,132 @@ func TestBuildGreaterEqThanOp(t *testing.T) {
 	assert.Equal(t, wantVal, gotVal)
 }
 
+func TestBuildNegOp(t *testing.T) {
+	wantVal := &UnaryOp{&NegOp{}, &IntConst{1}}
+	gotVal := BuildNegOp(BuildIntConst(1))
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestBuildAddOp(t *testing.T) {
+	wantVal := &BinaryOp{&IntConst{1}, &AddOp{}, &IntConst{2}}
+	gotVal := BuildAddOp(BuildIntConst(1), BuildIntConst(2))
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestBuildSubOp(t *testing.T) {
+	wantVal := &BinaryOp{&IntConst{1}, &SubOp{}, &IntConst{2}}
+	gotVal := BuildSubOp(BuildIntConst(1), BuildIntConst(2))
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestBuildMulOp(t *testing.T) {
+	wantVal := &BinaryOp{&IntConst{1}, &MulOp{}, &IntConst{2}}
+	gotVal := BuildMulOp(BuildIntConst(1), BuildIntConst(2))
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestBuildDivOp(t *testing.T) {
+	wantVal := &BinaryOp{&IntConst{1}, &DivOp{}, &IntConst{2}}
+	gotVal := BuildDivOp(BuildIntConst(1), BuildIntConst(2))
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestBuildModOp(t *testing.T) {
+	wantVal := &BinaryOp{&IntConst{1}, &ModOp{}, &IntConst{2}}
+	gotVal := BuildModOp(BuildIntConst(1), BuildIntConst(2))
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestBuildMatchOp(t *testing.T) {
+	wantVal := &BinaryOp{&StringConst{"fix: typo"}, &MatchOp{}, &StringConst{"^fix"}}
+	gotVal := BuildMatchOp(BuildStringConst("fix: typo"), BuildStringConst("^fix"))
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestBuildInOp(t *testing.T) {
+	wantVal := &BinaryOp{&IntConst{1}, &InOp{}, &Array{[]Expr{&IntConst{1}}}}
+	gotVal := BuildInOp(BuildIntConst(1), BuildArray([]Expr{BuildIntConst(1)}))
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestBuildNamedArg(t *testing.T) {
+	wantVal := &NamedArg{"total", &IntConst{2}}
+	gotVal := BuildNamedArg("total", BuildIntConst(2))
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestNamedArgKind(t *testing.T) {
+	wantVal := NAMED_ARG
+	gotVal := BuildNamedArg("total", BuildIntConst(2)).Kind()
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestNamedArgEquals_WhenDiffNames(t *testing.T) {
+	namedArg := BuildNamedArg("total", BuildIntConst(2))
+	otherNamedArg := BuildNamedArg("count", BuildIntConst(2))
+
+	assert.False(t, namedArg.equals(otherNamedArg))
+}
+
+func TestNamedArgEquals_WhenEqual(t *testing.T) {
+	namedArg := BuildNamedArg("total", BuildIntConst(2))
+	otherNamedArg := BuildNamedArg("total", BuildIntConst(2))
+
+	assert.True(t, namedArg.equals(otherNamedArg))
+}
+
+func TestBuildConditional(t *testing.T) {
+	wantVal := &Conditional{&BoolConst{true}, &IntConst{1}, &IntConst{2}}
+	gotVal := BuildConditional(BuildBoolConst(true), BuildIntConst(1), BuildIntConst(2))
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestConditionalKind(t *testing.T) {
+	wantVal := CONDITIONAL_CONST
+	gotVal := BuildConditional(BuildBoolConst(true), BuildIntConst(1), BuildIntConst(2)).Kind()
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestConditionalEquals_WhenDiffKinds(t *testing.T) {
+	conditional := BuildConditional(BuildBoolConst(true), BuildIntConst(1), BuildIntConst(2))
+	otherExpr := BuildIntConst(1)
+
+	assert.False(t, conditional.equals(otherExpr))
+}
+
+func TestConditionalEquals_WhenDiffBranches(t *testing.T) {
+	conditional := BuildConditional(BuildBoolConst(true), BuildIntConst(1), BuildIntConst(2))
+	otherConditional := BuildConditional(BuildBoolConst(true), BuildIntConst(1), BuildIntConst(3))
+
+	assert.False(t, conditional.equals(otherConditional))
+}
+
+func TestConditionalEquals_WhenEqual(t *testing.T) {
+	conditional := BuildConditional(BuildBoolConst(true), BuildIntConst(1), BuildIntConst(2))
+	otherConditional := BuildConditional(BuildBoolConst(true), BuildIntConst(1), BuildIntConst(2))
+
+	assert.True(t, conditional.equals(otherConditional))
+}
+
+func TestBuildNotMatchOp(t *testing.T) {
+	wantVal := &BinaryOp{&StringConst{"fix: typo"}, &NotMatchOp{}, &StringConst{"^fix"}}
+	gotVal := BuildNotMatchOp(BuildStringConst("fix: typo"), BuildStringConst("^fix"))
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
 func TestBuildCmpOp_WhenOpIsLessThanOp(t *testing.T) {
 	wantVal := &BinaryOp{&IntConst{1}, &LessThanOp{}, &IntConst{2}}
 	gotVal := BuildCmpOp(BuildIntConst(1), LESS_THAN_OP, BuildIntConst(2))
@@ -0,0 +1,52 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package aladino
+
+import "github.com/reviewpad/reviewpad/v3/engine"
+
+// Formatter rewrites specs and actions in their canonical form (see Print).
+// Specs with comments are not rewritten since Print does not keep them.
+type Formatter struct{}
+
+func NewFormatter() engine.Formatter {
+	return &Formatter{}
+}
+
+func (f *Formatter) FormatFunction(spec string) (string, error) {
+	def, err := ParseFunctionDef(spec)
+	if err != nil {
+		return "", err
+	}
+
+	if hasComments(spec) {
+		return "", engine.ErrSpecHasComments
+	}
+
+	return PrintFunctionDef(def), nil
+}
+
+func (f *Formatter) FormatExpr(spec string) (string, error) {
+	expr, err := Parse(spec)
+	if err != nil {
+		return "", err
+	}
+
+	if hasComments(spec) {
+		return "", engine.ErrSpecHasComments
+	}
+
+	return Print(expr), nil
+}
+
+// hasComments tells if the spec has comments, which are not kept by Print.
+func hasComments(spec string) bool {
+	lex := newAladinoLex(spec)
+
+	var lval AladinoSymType
+	for lex.Lex(&lval) != EOF {
+	}
+
+	return lex.hasComments
+}
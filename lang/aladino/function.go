@@ -0,0 +1,147 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package aladino
+
+import (
+	"fmt"
+	"strings"
+)
+
+// FunctionDef is a user-defined function declared in the functions section of a reviewpad file.
+// For instance, touches(path: String): Bool = $hasFilePattern($path)
+// declares the function $touches which can be called like any built-in.
+type FunctionDef struct {
+	name string
+	// parameters are typed variables (TypedExpr)
+	parameters []Expr
+	returnType Type
+	body       Expr
+}
+
+func BuildFunctionDef(name string, parameters []Expr, returnType Type, body Expr) *FunctionDef {
+	return &FunctionDef{name, parameters, returnType, body}
+}
+
+func ParseFunctionDef(input string) (*FunctionDef, error) {
+	def, _, err := parseFunctionDef(input)
+	return def, err
+}
+
+// parseFunctionDef builds the function definition of the input along with the offset in the input of each expression.
+func parseFunctionDef(input string) (*FunctionDef, map[Expr]int, error) {
+	input = strings.TrimRight(input, "\n")
+	lex := newAladinoLex(input)
+	lex.startToken = TK_FUNCTION_DEF
+	res := AladinoParse(lex)
+
+	if lex.err != nil {
+		return nil, nil, lex.err
+	}
+
+	if res != 0 {
+		return nil, nil, &ParseError{Input: input, Offset: lex.tokenOffset}
+	}
+
+	return lex.functionDef, lex.positions, nil
+}
+
+func (def *FunctionDef) Name() string {
+	return def.name
+}
+
+// Parameters returns the typed variables (TypedExpr) of the function.
+func (def *FunctionDef) Parameters() []Expr {
+	return copyExprs(def.parameters)
+}
+
+func (def *FunctionDef) ReturnType() Type {
+	return def.returnType
+}
+
+func (def *FunctionDef) Body() Expr {
+	return def.body
+}
+
+func (def *FunctionDef) paramName(i int) string {
+	return def.parameters[i].(*TypedExpr).expr.(*Variable).ident
+}
+
+// functionType returns the type of the function, where the parameters can be passed by name.
+func (def *FunctionDef) functionType() *FunctionType {
+	params := make([]*Param, len(def.parameters))
+	for i, param := range def.parameters {
+		params[i] = BuildParam(def.paramName(i), param.(*TypedExpr).typeOf)
+	}
+
+	return BuildFunctionTypeWithParams(params, def.returnType)
+}
+
+// typecheck checks that the body of the function has its return type
+// when the parameters are bound to their types.
+func (def *FunctionDef) typecheck(env TypeEnv) error {
+	if _, ok := env[def.name]; ok {
+		return &TypeError{
+			Message: fmt.Sprintf("function %v is already defined", def.name),
+		}
+	}
+
+	bindings := make(TypeEnv, len(def.parameters))
+	for i, param := range def.parameters {
+		paramName := def.paramName(i)
+		if _, ok := bindings[paramName]; ok {
+			return &TypeError{
+				Expr:    param,
+				Message: fmt.Sprintf("duplicate parameter %v of %v", paramName, def.name),
+			}
+		}
+
+		bindings[paramName] = param.(*TypedExpr).typeOf
+	}
+
+	bodyType, err := def.body.typeinfer(env.extend(bindings))
+	if err != nil {
+		return err
+	}
+
+	if bodyType == nil || !unify(def.returnType, bodyType, make(substitution)) {
+		return &TypeError{
+			Expr:     def.body,
+			Expected: def.returnType,
+			Actual:   bodyType,
+			Message:  fmt.Sprintf("type inference failed: body of %v does not match its return type", def.name),
+		}
+	}
+
+	return nil
+}
+
+// builtIn returns the built-in that evaluates the body of the function
+// in a scope where the parameters are bound to the arguments.
+func (def *FunctionDef) builtIn() *BuiltInFunction {
+	return &BuiltInFunction{
+		Type: def.functionType(),
+		Code: func(e Env, args []Value) (Value, error) {
+			registers := make(RegisterMap, len(def.parameters))
+			for i := range def.parameters {
+				registers[def.paramName(i)] = args[i]
+			}
+
+			return evalExpr(newScopedEnv(e, registers), def.body)
+		},
+	}
+}
+
+// declareFunction type checks the function and adds it to the built-ins.
+// A function can only call the built-ins and the functions declared before it.
+func declareFunction(builtIns *BuiltIns, def *FunctionDef) error {
+	err := def.typecheck(newTypeEnvFromBuiltIns(builtIns))
+	if err != nil {
+		return err
+	}
+
+	builtIns.Functions[def.name] = def.builtIn()
+
+	return nil
+}
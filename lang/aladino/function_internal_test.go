@@ -0,0 +1,188 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package aladino
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseFunctionDef(t *testing.T) {
+	input := `isNamed(name: String, size: Int): Bool = $returnStr($name) == "john" && $size > 10`
+
+	wantDef := BuildFunctionDef(
+		"isNamed",
+		[]Expr{
+			BuildTypedExpr(BuildVariable("name"), BuildStringType()),
+			BuildTypedExpr(BuildVariable("size"), BuildIntType()),
+		},
+		BuildBoolType(),
+		BuildAndOp(
+			BuildEqOp(
+				BuildFunctionCall(BuildVariable("returnStr"), []Expr{BuildVariable("name")}),
+				BuildStringConst("john"),
+			),
+			BuildGreaterThanOp(BuildVariable("size"), BuildIntConst(10)),
+		),
+	)
+
+	gotDef, err := ParseFunctionDef(input)
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantDef, gotDef)
+}
+
+func TestParseFunctionDef_WhenNoParameters(t *testing.T) {
+	wantDef := BuildFunctionDef("isZero", []Expr{}, BuildBoolType(), BuildEqOp(BuildFunctionCall(BuildVariable("zeroConst"), []Expr{}), BuildIntConst(0)))
+
+	gotDef, err := ParseFunctionDef("isZero(): Bool = $zeroConst() == 0")
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantDef, gotDef)
+}
+
+func TestParseFunctionDef_WhenReturnTypeIsMissing(t *testing.T) {
+	gotDef, err := ParseFunctionDef("isZero() = $zeroConst() == 0")
+
+	assert.Nil(t, gotDef)
+	assert.EqualError(t, err, "parse error: failed to build AST on input isZero() = $zeroConst() == 0")
+}
+
+func TestParse_WhenFunctionDef(t *testing.T) {
+	gotExpr, err := Parse("isZero(): Bool = $zeroConst() == 0")
+
+	assert.Nil(t, gotExpr)
+	assert.NotNil(t, err)
+}
+
+func TestFunctionType(t *testing.T) {
+	def, err := ParseFunctionDef("touches(path: String, total: Int): Bool = true")
+	if err != nil {
+		assert.FailNow(t, "parse failed: %v", err)
+	}
+
+	wantType := BuildFunctionTypeWithParams(
+		[]*Param{BuildParam("path", BuildStringType()), BuildParam("total", BuildIntType())},
+		BuildBoolType(),
+	)
+
+	assert.Equal(t, wantType, def.functionType())
+}
+
+func TestTypeCheck_WhenFunctionDefIsWellTyped(t *testing.T) {
+	def, err := ParseFunctionDef("greet(name: String): String = $returnStr($name)")
+	if err != nil {
+		assert.FailNow(t, "parse failed: %v", err)
+	}
+
+	err = def.typecheck(MockTypeEnv())
+
+	assert.Nil(t, err)
+}
+
+func TestTypeCheck_WhenBodyDoesNotMatchReturnType(t *testing.T) {
+	def, err := ParseFunctionDef("greet(name: String): Bool = $returnStr($name)")
+	if err != nil {
+		assert.FailNow(t, "parse failed: %v", err)
+	}
+
+	err = def.typecheck(MockTypeEnv())
+
+	wantErr := &TypeError{
+		Expr:     def.body,
+		Expected: BuildBoolType(),
+		Actual:   BuildStringType(),
+		Message:  "type inference failed: body of greet does not match its return type",
+	}
+
+	assert.Equal(t, wantErr, err)
+}
+
+func TestTypeCheck_WhenFunctionParameterIsDuplicated(t *testing.T) {
+	def, err := ParseFunctionDef("greet(name: String, name: Int): String = $name")
+	if err != nil {
+		assert.FailNow(t, "parse failed: %v", err)
+	}
+
+	err = def.typecheck(MockTypeEnv())
+
+	wantErr := &TypeError{
+		Expr:    def.parameters[1],
+		Message: "duplicate parameter name of greet",
+	}
+
+	assert.Equal(t, wantErr, err)
+}
+
+func TestTypeCheck_WhenFunctionIsAlreadyDefined(t *testing.T) {
+	def, err := ParseFunctionDef("returnStr(name: String): String = $name")
+	if err != nil {
+		assert.FailNow(t, "parse failed: %v", err)
+	}
+
+	err = def.typecheck(MockTypeEnv())
+
+	assert.EqualError(t, err, "function returnStr is already defined")
+}
+
+func TestTypeCheck_WhenBodyUsesUnknownVariable(t *testing.T) {
+	def, err := ParseFunctionDef("greet(name: String): String = $other")
+	if err != nil {
+		assert.FailNow(t, "parse failed: %v", err)
+	}
+
+	err = def.typecheck(MockTypeEnv())
+
+	assert.NotNil(t, err)
+}
+
+func TestDeclareFunction(t *testing.T) {
+	mockedEnv, err := MockDefaultEnv(nil, nil)
+	if err != nil {
+		assert.FailNow(t, "MockDefaultEnv failed: %v", err)
+	}
+
+	def, err := ParseFunctionDef(`greet(name: String, greeting: String): String = if $name == "john" then $greeting else $returnStr($name)`)
+	if err != nil {
+		assert.FailNow(t, "parse failed: %v", err)
+	}
+
+	err = declareFunction(mockedEnv.GetBuiltIns(), def)
+	if err != nil {
+		assert.FailNow(t, "declareFunction failed: %v", err)
+	}
+
+	expr, err := Parse(`$greet("john", greeting: "hello")`)
+	if err != nil {
+		assert.FailNow(t, "parse failed: %v", err)
+	}
+
+	exprType, err := TypeInference(mockedEnv, expr)
+	assert.Nil(t, err)
+	assert.Equal(t, BuildStringType(), exprType)
+
+	gotVal, err := Eval(mockedEnv, expr)
+
+	assert.Nil(t, err)
+	assert.Equal(t, BuildStringValue("hello"), gotVal)
+}
+
+func TestDeclareFunction_WhenFunctionCallsPreviousFunction(t *testing.T) {
+	builtIns := MockBuiltIns()
+
+	first, err := ParseFunctionDef("double(n: Int): Int = $n * 2")
+	if err != nil {
+		assert.FailNow(t, "parse failed: %v", err)
+	}
+
+	second, err := ParseFunctionDef("quadruple(n: Int): Int = $double($double($n))")
+	if err != nil {
+		assert.FailNow(t, "parse failed: %v", err)
+	}
+
+	assert.Nil(t, declareFunction(builtIns, first))
+	assert.Nil(t, declareFunction(builtIns, second))
+}
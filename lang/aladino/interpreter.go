@@ -6,6 +6,7 @@ package aladino
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 
@@ -18,6 +19,9 @@ import (
 
 type Interpreter struct {
 	Env Env
+	// compiled holds the ASTs of the specs and actions that were type checked when the reviewpad file was linted.
+	// The specs and actions that are not compiled are parsed and type checked when they are evaluated.
+	compiled *Compiled
 }
 
 func execLog(val string) {
@@ -55,6 +59,16 @@ func evalGroup(env Env, expr Expr) (Value, error) {
 }
 
 func (i *Interpreter) ProcessGroup(groupName string, kind engine.GroupKind, typeOf engine.GroupType, expr, paramExpr, whereExpr string) error {
+	if exprAST, ok := i.compiled.group(groupSource{typeOf, expr, paramExpr, whereExpr}); ok {
+		value, err := Eval(i.Env, exprAST)
+		if err != nil {
+			return fmt.Errorf("ProcessGroup:eval %v", err)
+		}
+
+		i.Env.GetRegisterMap()[groupName] = value
+		return nil
+	}
+
 	exprAST, err := buildGroupAST(typeOf, expr, paramExpr, whereExpr)
 	if err != nil {
 		return fmt.Errorf("ProcessGroup:buildGroupAST: %v", err)
@@ -69,6 +83,37 @@ func (i *Interpreter) ProcessGroup(groupName string, kind engine.GroupKind, type
 	return nil
 }
 
+// ProcessCompiled makes the interpreter reuse the specs and actions that were compiled when the reviewpad file was linted.
+func (i *Interpreter) ProcessCompiled(compiled engine.Compiled) error {
+	aladinoCompiled, ok := compiled.(*Compiled)
+	if !ok {
+		return fmt.Errorf("ProcessCompiled: unexpected compiled file %T", compiled)
+	}
+
+	i.compiled = aladinoCompiled
+	return nil
+}
+
+func (i *Interpreter) ProcessFunction(spec string) error {
+	// A compiled function was type checked along with the built-ins, so it only needs to be declared
+	if def, ok := i.compiled.function(spec); ok {
+		i.Env.GetBuiltIns().Functions[def.name] = def.builtIn()
+		return nil
+	}
+
+	def, err := ParseFunctionDef(spec)
+	if err != nil {
+		return fmt.Errorf("ProcessFunction:parse: %v", err)
+	}
+
+	err = declareFunction(i.Env.GetBuiltIns(), def)
+	if err != nil {
+		return fmt.Errorf("ProcessFunction:declare: %v", err)
+	}
+
+	return nil
+}
+
 func BuildInternalLabelID(id string) string {
 	return fmt.Sprintf("@label:%v", id)
 }
@@ -91,51 +136,151 @@ func (i *Interpreter) ProcessRule(name, spec string) error {
 	return nil
 }
 
+// EvalExpr evaluates the condition expr.
+// The result is cached in the memo, so a rule is evaluated at most once per run.
 func EvalExpr(env Env, kind, expr string) (bool, error) {
-	exprAST, err := Parse(expr)
+	return evalCompiledExpr(env, expr, nil)
+}
+
+// evalCompiledExpr evaluates the condition expr whose type checked AST is exprAST.
+// When exprAST is nil, the condition is parsed and type checked unless its result is already in the memo.
+func evalCompiledExpr(env Env, expr string, exprAST Expr) (bool, error) {
+	memo := env.GetMemo()
+	if activated, ok := memo[conditionMemoKey(expr)]; ok {
+		return activated.(*BoolValue).Val, nil
+	}
+
+	if exprAST == nil {
+		var err error
+		exprAST, err = typeCheckCondition(env, expr)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	activated, err := evalCondition(env, expr, exprAST)
 	if err != nil {
 		return false, err
 	}
 
+	if memo != nil {
+		memo[conditionMemoKey(expr)] = BuildBoolValue(activated)
+	}
+
+	return activated, nil
+}
+
+func typeCheckCondition(env Env, expr string) (Expr, error) {
+	exprAST, err := Parse(expr)
+	if err != nil {
+		return nil, err
+	}
+
 	exprType, err := TypeInference(env, exprAST)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 
 	if exprType.Kind() != BOOL_TYPE {
-		return false, fmt.Errorf("expression %v is not a condition", expr)
+		return nil, fmt.Errorf("expression %v is not a condition", expr)
+	}
+
+	return exprAST, nil
+}
+
+// evalCondition evaluates the condition and, when the report explains the rules, records its trace.
+func evalCondition(env Env, expr string, exprAST Expr) (bool, error) {
+	report := env.GetReport()
+	if report == nil || !report.Explain {
+		return EvalCondition(env, exprAST)
+	}
+
+	activated, trace, err := EvalConditionWithTrace(env, exprAST)
+	if err != nil {
+		return false, err
 	}
 
-	return EvalCondition(env, exprAST)
+	execLogf("explain %v:\n%v", expr, trace.Explain())
+
+	report.addTrace(expr, trace)
+
+	return activated, nil
 }
 
 func (i *Interpreter) EvalExpr(kind, expr string) (bool, error) {
-	return EvalExpr(i.Env, kind, expr)
+	exprAST, _ := i.compiled.condition(expr)
+	return evalCompiledExpr(i.Env, expr, exprAST)
+}
+
+// StopError is the error of an action that stops the execution of the program (e.g. $fail),
+// even when the errors of the program are ignored.
+type StopError interface {
+	error
+	StopsExecution() bool
+}
+
+// stopsExecution tells if err stops the execution of the program (see StopError).
+func stopsExecution(err error) bool {
+	var stopErr StopError
+	return errors.As(err, &stopErr) && stopErr.StopsExecution()
 }
 
 func (i *Interpreter) ExecProgram(program *engine.Program) error {
 	execLog("executing program:")
 
+	var errs engine.ExecErrors
 	for _, statement := range program.Statements {
 		err := i.ExecStatement(statement)
 		if err != nil {
-			return err
+			if !program.IgnoreErrors {
+				return err
+			}
+
+			// The errors of the statements executed so far are returned along with the one that stops the program
+			if stopsExecution(err) {
+				if len(errs) == 0 {
+					return err
+				}
+
+				return append(errs, &engine.StatementError{Statement: statement, Err: err})
+			}
+
+			execLogf("\taction %v failed: %v", statement.Code, err)
+
+			statementErr := &engine.StatementError{Statement: statement, Err: err}
+			i.Env.GetReport().addError(statementErr)
+			errs = append(errs, statementErr)
 		}
 	}
 
 	execLog("execution done")
 
+	// The program fails when any of its statements failed
+	if len(errs) > 0 {
+		return errs
+	}
+
 	return nil
 }
 
-func (i *Interpreter) ExecStatement(statement *engine.Statement) error {
-	statRaw := statement.Code
-	statAST, err := Parse(statRaw)
+// compileStatement returns the type checked AST of the statement.
+// The statement is only parsed and type checked when it was not compiled with the reviewpad file.
+func (i *Interpreter) compileStatement(statement *engine.Statement) (ExecExpr, error) {
+	if execStatAST, ok := i.compiled.action(statement.Code); ok {
+		return execStatAST, nil
+	}
+
+	statAST, err := Parse(statement.Code)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	execStatAST, err := TypeCheckExec(i.Env, statAST)
+	return TypeCheckExec(i.Env, statAST)
+}
+
+func (i *Interpreter) ExecStatement(statement *engine.Statement) error {
+	statRaw := statement.Code
+	execStatAST, err := i.compileStatement(statement)
 	if err != nil {
 		return err
 	}
@@ -146,11 +291,22 @@ func (i *Interpreter) ExecStatement(statement *engine.Statement) error {
 	}
 
 	i.Env.GetReport().addToReport(statement)
+	i.Env.GetReport().explainRules(statement, i.Env.GetRegisterMap())
 
 	execLogf("\taction %v executed", statRaw)
 	return nil
 }
 
+// DescribeStatement returns the side effect that the statement would cause without executing it.
+func (i *Interpreter) DescribeStatement(statement *engine.Statement) (string, error) {
+	execStatAST, err := i.compileStatement(statement)
+	if err != nil {
+		return "", err
+	}
+
+	return execStatAST.describe(i.Env)
+}
+
 func (i *Interpreter) Report(mode string) error {
 	execLog("generating report")
 
@@ -187,9 +343,9 @@ func NewInterpreter(
 	pullRequest *github.PullRequest,
 	eventPayload interface{},
 	builtIns *BuiltIns,
-
+	clock Clock,
 ) (engine.Interpreter, error) {
-	evalEnv, err := NewEvalEnv(ctx, gitHubClient, gitHubClientGQL, collector, pullRequest, eventPayload, builtIns)
+	evalEnv, err := NewEvalEnv(ctx, gitHubClient, gitHubClientGQL, collector, pullRequest, eventPayload, builtIns, clock)
 	if err != nil {
 		return nil, err
 	}
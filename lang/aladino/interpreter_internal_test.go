@@ -7,6 +7,7 @@ package aladino
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
@@ -293,6 +294,97 @@ func TestProcessRule(t *testing.T) {
 	assert.Equal(t, wantVal, gotVal)
 }
 
+func TestProcessFunction(t *testing.T) {
+	mockedEnv, err := MockDefaultEnv(nil, nil)
+	if err != nil {
+		assert.FailNow(t, fmt.Sprintf("MockDefaultEnv failed: %v", err))
+	}
+
+	mockedInterpreter := &Interpreter{
+		Env: mockedEnv,
+	}
+
+	err = mockedInterpreter.ProcessFunction("isZero(n: Int): Bool = $n == 0")
+	assert.Nil(t, err)
+
+	gotVal, err := mockedInterpreter.EvalExpr("patch", "$isZero($zeroConst())")
+
+	assert.Nil(t, err)
+	assert.True(t, gotVal)
+}
+
+func TestProcessFunction_DoesNotChangeTheBuiltIns(t *testing.T) {
+	builtIns := MockBuiltIns()
+
+	mockedEnv, err := MockDefaultEnvWithBuiltIns(nil, nil, builtIns)
+	if err != nil {
+		assert.FailNow(t, fmt.Sprintf("MockDefaultEnvWithBuiltIns failed: %v", err))
+	}
+
+	mockedInterpreter := &Interpreter{
+		Env: mockedEnv,
+	}
+
+	err = mockedInterpreter.ProcessFunction("isZero(n: Int): Bool = $n == 0")
+
+	assert.Nil(t, err)
+	assert.NotNil(t, mockedInterpreter.Env.GetBuiltIns().Functions["isZero"])
+	assert.Nil(t, builtIns.Functions["isZero"])
+}
+
+func TestProcessFunction_WhenTypeCheckFails(t *testing.T) {
+	mockedEnv, err := MockDefaultEnv(nil, nil)
+	if err != nil {
+		assert.FailNow(t, fmt.Sprintf("MockDefaultEnv failed: %v", err))
+	}
+
+	mockedInterpreter := &Interpreter{
+		Env: mockedEnv,
+	}
+
+	err = mockedInterpreter.ProcessFunction("isZero(n: Int): Bool = $n")
+
+	assert.EqualError(t, err, "ProcessFunction:declare: type inference failed: body of isZero does not match its return type")
+}
+
+func TestEvalExpr_RecordsTrace(t *testing.T) {
+	mockedEnv, err := MockDefaultEnv(nil, nil)
+	if err != nil {
+		assert.FailNow(t, fmt.Sprintf("MockDefaultEnv failed: %v", err))
+	}
+
+	mockedEnv.GetReport().Explain = true
+
+	spec := "$zeroConst() == 0"
+
+	gotVal, err := EvalExpr(mockedEnv, "patch", spec)
+
+	wantTrace := &Trace{
+		Expr:  "== 0",
+		Value: BuildBoolValue(true),
+		Children: []*Trace{
+			{Expr: "$zeroConst()", Value: BuildIntValue(0)},
+		},
+	}
+
+	assert.Nil(t, err)
+	assert.True(t, gotVal)
+	assert.Equal(t, wantTrace, mockedEnv.GetReport().traces[spec])
+}
+
+func TestEvalExpr_WhenReportDoesNotExplain(t *testing.T) {
+	mockedEnv, err := MockDefaultEnv(nil, nil)
+	if err != nil {
+		assert.FailNow(t, fmt.Sprintf("MockDefaultEnv failed: %v", err))
+	}
+
+	gotVal, err := EvalExpr(mockedEnv, "patch", "$zeroConst() == 0")
+
+	assert.Nil(t, err)
+	assert.True(t, gotVal)
+	assert.Empty(t, mockedEnv.GetReport().traces)
+}
+
 func TestEvalExpr_WhenParseFails(t *testing.T) {
 	mockedEnv, err := MockDefaultEnv(nil, nil)
 	if err != nil {
@@ -454,6 +546,382 @@ func TestExecProgram(t *testing.T) {
 	assert.Equal(t, wantVal, gotVal)
 }
 
+func TestExecProgram_WhenErrorsAreIgnored(t *testing.T) {
+	totalExecuted := 0
+	builtIns := &BuiltIns{
+		Actions: map[string]*BuiltInAction{
+			"fail": {
+				Type: BuildFunctionType([]Type{BuildStringType()}, nil),
+				Code: func(e Env, args []Value) error {
+					return fmt.Errorf("%v", args[0].(*StringValue).Val)
+				},
+			},
+			"succeed": {
+				Type: BuildFunctionType([]Type{}, nil),
+				Code: func(e Env, args []Value) error {
+					totalExecuted++
+					return nil
+				},
+			},
+		},
+	}
+
+	mockedEnv, err := MockDefaultEnvWithBuiltIns(nil, nil, builtIns)
+	if err != nil {
+		assert.FailNow(t, fmt.Sprintf("MockDefaultEnvWithBuiltIns failed: %v", err))
+	}
+
+	mockedInterpreter := &Interpreter{
+		Env: mockedEnv,
+	}
+
+	metadata := &engine.Metadata{
+		Workflow: engine.PadWorkflow{
+			Name: "test",
+		},
+		TriggeredBy: []engine.PadWorkflowRule{
+			{Rule: "testRule"},
+		},
+	}
+	firstFailure := &engine.Statement{Code: `$fail("first")`, Metadata: metadata}
+	secondFailure := &engine.Statement{Code: `$fail("second")`, Metadata: metadata}
+
+	program := &engine.Program{
+		Statements: []*engine.Statement{
+			firstFailure,
+			{Code: "$succeed()", Metadata: metadata},
+			secondFailure,
+			{Code: "$succeed()", Metadata: metadata},
+		},
+		IgnoreErrors: true,
+	}
+
+	err = mockedInterpreter.ExecProgram(program)
+
+	wantErrs := []*engine.StatementError{
+		{Statement: firstFailure, Err: fmt.Errorf("first")},
+		{Statement: secondFailure, Err: fmt.Errorf("second")},
+	}
+
+	assert.Equal(t, engine.ExecErrors(wantErrs), err)
+	assert.Equal(t, 2, totalExecuted)
+	assert.Equal(t, wantErrs, mockedEnv.GetReport().Errors)
+}
+
+// stopError is the error of an action that stops the program, like $fail.
+type stopError struct{}
+
+func (e *stopError) Error() string {
+	return "stop"
+}
+
+func (e *stopError) StopsExecution() bool {
+	return true
+}
+
+func TestExecProgram_WhenErrorsAreIgnoredAndActionStops(t *testing.T) {
+	totalExecuted := 0
+	builtIns := &BuiltIns{
+		Actions: map[string]*BuiltInAction{
+			"stop": {
+				Type: BuildFunctionType([]Type{}, nil),
+				Code: func(e Env, args []Value) error {
+					return &stopError{}
+				},
+			},
+			"succeed": {
+				Type: BuildFunctionType([]Type{}, nil),
+				Code: func(e Env, args []Value) error {
+					totalExecuted++
+					return nil
+				},
+			},
+		},
+	}
+
+	mockedEnv, err := MockDefaultEnvWithBuiltIns(nil, nil, builtIns)
+	if err != nil {
+		assert.FailNow(t, fmt.Sprintf("MockDefaultEnvWithBuiltIns failed: %v", err))
+	}
+
+	mockedInterpreter := &Interpreter{
+		Env: mockedEnv,
+	}
+
+	metadata := &engine.Metadata{
+		Workflow: engine.PadWorkflow{
+			Name: "test",
+		},
+	}
+
+	program := &engine.Program{
+		Statements: []*engine.Statement{
+			{Code: "$succeed()", Metadata: metadata},
+			{Code: "$stop()", Metadata: metadata},
+			{Code: "$succeed()", Metadata: metadata},
+		},
+		IgnoreErrors: true,
+	}
+
+	err = mockedInterpreter.ExecProgram(program)
+
+	assert.Equal(t, &stopError{}, err)
+	assert.Equal(t, 1, totalExecuted)
+	assert.Nil(t, mockedEnv.GetReport().Errors)
+}
+
+func TestExecProgram_WhenErrorsAreIgnoredAndActionStopsAfterFailures(t *testing.T) {
+	builtIns := &BuiltIns{
+		Actions: map[string]*BuiltInAction{
+			"fail": {
+				Type: BuildFunctionType([]Type{BuildStringType()}, nil),
+				Code: func(e Env, args []Value) error {
+					return fmt.Errorf("%v", args[0].(*StringValue).Val)
+				},
+			},
+			"stop": {
+				Type: BuildFunctionType([]Type{}, nil),
+				Code: func(e Env, args []Value) error {
+					return &stopError{}
+				},
+			},
+		},
+	}
+
+	mockedEnv, err := MockDefaultEnvWithBuiltIns(nil, nil, builtIns)
+	if err != nil {
+		assert.FailNow(t, fmt.Sprintf("MockDefaultEnvWithBuiltIns failed: %v", err))
+	}
+
+	mockedInterpreter := &Interpreter{
+		Env: mockedEnv,
+	}
+
+	metadata := &engine.Metadata{
+		Workflow: engine.PadWorkflow{
+			Name: "test",
+		},
+	}
+	failure := &engine.Statement{Code: `$fail("first")`, Metadata: metadata}
+	stop := &engine.Statement{Code: "$stop()", Metadata: metadata}
+
+	program := &engine.Program{
+		Statements:   []*engine.Statement{failure, stop},
+		IgnoreErrors: true,
+	}
+
+	err = mockedInterpreter.ExecProgram(program)
+
+	wantErr := engine.ExecErrors{
+		{Statement: failure, Err: fmt.Errorf("first")},
+		{Statement: stop, Err: &stopError{}},
+	}
+
+	var stopErr StopError
+
+	assert.Equal(t, wantErr, err)
+	assert.True(t, errors.As(err, &stopErr))
+	assert.Equal(t, []*engine.StatementError{wantErr[0]}, mockedEnv.GetReport().Errors)
+}
+
+func TestExecProgram_WhenErrorsAreNotIgnored(t *testing.T) {
+	builtIns := &BuiltIns{
+		Actions: map[string]*BuiltInAction{
+			"fail": {
+				Type: BuildFunctionType([]Type{BuildStringType()}, nil),
+				Code: func(e Env, args []Value) error {
+					return fmt.Errorf("%v", args[0].(*StringValue).Val)
+				},
+			},
+		},
+	}
+
+	mockedEnv, err := MockDefaultEnvWithBuiltIns(nil, nil, builtIns)
+	if err != nil {
+		assert.FailNow(t, fmt.Sprintf("MockDefaultEnvWithBuiltIns failed: %v", err))
+	}
+
+	mockedInterpreter := &Interpreter{
+		Env: mockedEnv,
+	}
+
+	program := &engine.Program{
+		Statements: []*engine.Statement{
+			{Code: `$fail("first")`},
+			{Code: `$fail("second")`},
+		},
+	}
+
+	err = mockedInterpreter.ExecProgram(program)
+
+	assert.EqualError(t, err, "first")
+	assert.Nil(t, mockedEnv.GetReport().Errors)
+}
+
+func TestDescribeStatement(t *testing.T) {
+	builtIns := &BuiltIns{
+		Actions: map[string]*BuiltInAction{
+			"addLabel": {
+				Type: BuildFunctionType([]Type{BuildStringType()}, nil),
+				Code: func(e Env, args []Value) error {
+					return fmt.Errorf("addLabel must not run")
+				},
+				Describe: func(e Env, args []Value) string {
+					return fmt.Sprintf("add label %v", args[0].(*StringValue).Val)
+				},
+			},
+		},
+	}
+
+	mockedEnv, err := MockDefaultEnvWithBuiltIns(nil, nil, builtIns)
+	if err != nil {
+		assert.FailNow(t, fmt.Sprintf("MockDefaultEnvWithBuiltIns failed: %v", err))
+	}
+
+	mockedInterpreter := &Interpreter{
+		Env: mockedEnv,
+	}
+
+	gotEffect, err := mockedInterpreter.DescribeStatement(&engine.Statement{Code: `$addLabel(if 1 == 1 then "large" else "small")`})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "add label large", gotEffect)
+}
+
+func TestDescribeStatement_WhenActionHasNoDescription(t *testing.T) {
+	builtIns := &BuiltIns{
+		Actions: map[string]*BuiltInAction{
+			"merge": {
+				Type: BuildFunctionTypeWithParams(
+					[]*Param{BuildOptionalParam("method", BuildStringType(), BuildStringValue("merge"))},
+					nil,
+				),
+				Code: func(e Env, args []Value) error {
+					return nil
+				},
+			},
+		},
+	}
+
+	mockedEnv, err := MockDefaultEnvWithBuiltIns(nil, nil, builtIns)
+	if err != nil {
+		assert.FailNow(t, fmt.Sprintf("MockDefaultEnvWithBuiltIns failed: %v", err))
+	}
+
+	mockedInterpreter := &Interpreter{
+		Env: mockedEnv,
+	}
+
+	gotEffect, err := mockedInterpreter.DescribeStatement(&engine.Statement{Code: "$merge()"})
+
+	assert.Nil(t, err)
+	assert.Equal(t, `run $merge("merge")`, gotEffect)
+}
+
+func TestBuildPlan(t *testing.T) {
+	builtIns := &BuiltIns{
+		Actions: map[string]*BuiltInAction{
+			"addLabel": {
+				Type: BuildFunctionType([]Type{BuildStringType()}, nil),
+				Code: func(e Env, args []Value) error {
+					return fmt.Errorf("addLabel must not run")
+				},
+				Describe: func(e Env, args []Value) string {
+					return fmt.Sprintf("add label %v", args[0].(*StringValue).Val)
+				},
+			},
+		},
+	}
+
+	mockedEnv, err := MockDefaultEnvWithBuiltIns(nil, nil, builtIns)
+	if err != nil {
+		assert.FailNow(t, fmt.Sprintf("MockDefaultEnvWithBuiltIns failed: %v", err))
+	}
+
+	mockedInterpreter := &Interpreter{
+		Env: mockedEnv,
+	}
+
+	program := &engine.Program{
+		Statements: []*engine.Statement{
+			{
+				Code: `$addLabel("large")`,
+				Metadata: &engine.Metadata{
+					Workflow:    engine.PadWorkflow{Name: "label-size"},
+					TriggeredBy: []engine.PadWorkflowRule{{Rule: "is-large"}},
+				},
+			},
+		},
+	}
+
+	gotPlan := engine.BuildPlan(program, mockedInterpreter)
+
+	wantPlan := &engine.Plan{
+		Steps: []*engine.PlanStep{
+			{
+				Action:   `$addLabel("large")`,
+				Effect:   "add label large",
+				Workflow: "label-size",
+				Rules:    []string{"is-large"},
+			},
+		},
+	}
+
+	assert.Equal(t, wantPlan, gotPlan)
+	assert.Empty(t, mockedEnv.GetReport().WorkflowDetails)
+}
+
+func TestBuildPlan_WhenDescribeStatementFails(t *testing.T) {
+	builtIns := &BuiltIns{
+		Actions: map[string]*BuiltInAction{
+			"addLabel": {
+				Type: BuildFunctionType([]Type{BuildStringType()}, nil),
+				Code: func(e Env, args []Value) error {
+					return fmt.Errorf("addLabel must not run")
+				},
+				Describe: func(e Env, args []Value) string {
+					return fmt.Sprintf("add label %v", args[0].(*StringValue).Val)
+				},
+			},
+		},
+	}
+
+	mockedEnv, err := MockDefaultEnvWithBuiltIns(nil, nil, builtIns)
+	if err != nil {
+		assert.FailNow(t, fmt.Sprintf("MockDefaultEnvWithBuiltIns failed: %v", err))
+	}
+
+	mockedInterpreter := &Interpreter{
+		Env: mockedEnv,
+	}
+
+	program := &engine.Program{
+		Statements: []*engine.Statement{
+			{Code: "$unknown()"},
+			{Code: `$addLabel("large")`},
+		},
+	}
+
+	gotPlan := engine.BuildPlan(program, mockedInterpreter)
+
+	wantPlan := &engine.Plan{
+		Steps: []*engine.PlanStep{
+			{
+				Action: "$unknown()",
+				Error:  "no type for built-in unknown. Please check if the mode in the reviewpad.yml file supports it",
+				Rules:  []string{},
+			},
+			{
+				Action: `$addLabel("large")`,
+				Effect: "add label large",
+				Rules:  []string{},
+			},
+		},
+	}
+
+	assert.Equal(t, wantPlan, gotPlan)
+}
+
 func TestExecStatement_WhenParseFails(t *testing.T) {
 	mockedEnv, err := MockDefaultEnv(nil, nil)
 	if err != nil {
@@ -836,6 +1304,7 @@ func TestNewInterpreter_WhenNewEvalEnvFails(t *testing.T) {
 		GetDefaultMockPullRequestDetails(),
 		nil,
 		nil,
+		nil,
 	)
 
 	assert.Nil(t, gotInterpreter)
@@ -860,6 +1329,7 @@ func TestNewInterpreter(t *testing.T) {
 		mockedEnv.GetPullRequest(),
 		mockedEnv.GetEventPayload(),
 		mockedEnv.GetBuiltIns(),
+		mockedEnv.GetClock(),
 	)
 
 	assert.Nil(t, err)
@@ -6,14 +6,36 @@ package aladino
 
 import (
 	"fmt"
-	"log"
 	"regexp"
 	"strconv"
+	"strings"
 )
 
 type AladinoLex struct {
-	input string
-	ast   Expr
+	source string
+	input  string
+	ast    Expr
+	// offset of the last token read from the input
+	tokenOffset int
+	positions   map[Expr]int
+	err         *ParseError
+	// startToken is returned before the tokens of the input to select what the parser reads (0 for an expression)
+	startToken  int
+	functionDef *FunctionDef
+	// hasComments tells if a comment was skipped in the input
+	hasComments bool
+}
+
+func newAladinoLex(input string) *AladinoLex {
+	return &AladinoLex{
+		source:    input,
+		input:     input,
+		positions: make(map[Expr]int),
+	}
+}
+
+func (l *AladinoLex) offset() int {
+	return len(l.source) - len(l.input)
 }
 
 const EOF = 0
@@ -25,6 +47,17 @@ type tokenDef struct {
 }
 
 var tokens = []tokenDef{
+	{
+		// Examples:
+		// 30s (seconds)
+		// 15m (minutes)
+		// 12h (hours)
+		// 3d (days)
+		// 2w (weeks)
+		regex: regexp.MustCompile(`^[0-9]+[smhdw]\b`),
+		kind:  "duration",
+		token: DURATION,
+	},
 	{
 		// Allowed formats:
 		// YYYYMMDD - e.g. 20220405
@@ -47,7 +80,16 @@ var tokens = []tokenDef{
 		token: RELATIVETIMESTAMP,
 	},
 	{
-		regex: regexp.MustCompile(`^[0-9]*\.?[0-9]+([eE][-+]?[0-9]+)?`),
+		// Examples:
+		// 0.8
+		// .5
+		// 2e3
+		regex: regexp.MustCompile(`^([0-9]*\.[0-9]+([eE][-+]?[0-9]+)?|[0-9]+[eE][-+]?[0-9]+)`),
+		kind:  "float",
+		token: FLOAT,
+	},
+	{
+		regex: regexp.MustCompile(`^[0-9]+`),
 		kind:  "number",
 		token: NUMBER,
 	},
@@ -61,13 +103,36 @@ var tokens = []tokenDef{
 		kind:  "bool",
 		token: FALSE,
 	},
+	{
+		regex: regexp.MustCompile(`^in\b`),
+		kind:  "keyword",
+		token: TK_IN,
+	},
+	{
+		regex: regexp.MustCompile(`^if\b`),
+		kind:  "keyword",
+		token: TK_IF,
+	},
+	{
+		regex: regexp.MustCompile(`^then\b`),
+		kind:  "keyword",
+		token: TK_THEN,
+	},
+	{
+		regex: regexp.MustCompile(`^else\b`),
+		kind:  "keyword",
+		token: TK_ELSE,
+	},
 	{
 		regex: regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9]*`),
 		kind:  "identifier",
 		token: IDENTIFIER,
 	},
 	{
-		regex: regexp.MustCompile(`^"[^"]*"`),
+		// Examples:
+		// "Lorem \"ipsum\""
+		// 'Lorem "ipsum"\n'
+		regex: regexp.MustCompile(`^("(\\.|[^"\\])*"|'(\\.|[^'\\])*')`),
 		kind:  "stringLiteral",
 		token: STRINGLITERAL,
 	},
@@ -76,6 +141,11 @@ var tokens = []tokenDef{
 		kind:  "binop",
 		token: TK_CMPOP,
 	},
+	{
+		regex: regexp.MustCompile(`^=>`),
+		kind:  "arrow",
+		token: TK_ARROW,
+	},
 	{
 		regex: regexp.MustCompile(`^==`),
 		kind:  "binop",
@@ -86,6 +156,16 @@ var tokens = []tokenDef{
 		kind:  "binop",
 		token: TK_NEQ,
 	},
+	{
+		regex: regexp.MustCompile(`^=~`),
+		kind:  "binop",
+		token: TK_MATCH,
+	},
+	{
+		regex: regexp.MustCompile(`^!~`),
+		kind:  "binop",
+		token: TK_NMATCH,
+	},
 	{
 		regex: regexp.MustCompile(`^!`),
 		kind:  "binop",
@@ -105,8 +185,15 @@ var tokens = []tokenDef{
 
 func (l *AladinoLex) Lex(lval *AladinoSymType) int {
 	// fmt.Printf("lex: input: %v\n", l.input)
-	// Skip spaces.
-	for ; len(l.input) > 0 && isSpace(l.input[0]); l.input = l.input[1:] {
+	l.skipSpacesAndComments()
+
+	l.tokenOffset = l.offset()
+	lval.pos = l.tokenOffset
+
+	if l.startToken != 0 {
+		startToken := l.startToken
+		l.startToken = 0
+		return startToken
 	}
 
 	// Check if the input has ended.
@@ -126,13 +213,27 @@ func (l *AladinoLex) Lex(lval *AladinoSymType) int {
 		case "number":
 			num, err := strconv.Atoi(str)
 			if err != nil {
-				fmt.Printf("error in atoi %v\n", err)
-				log.Fatal(err)
+				l.Error(fmt.Sprintf("invalid number %v", str))
+				return EOF
 			}
 			lval.int = num
+		case "float":
+			num, err := strconv.ParseFloat(str, 64)
+			if err != nil {
+				l.Error(fmt.Sprintf("invalid number %v", str))
+				return EOF
+			}
+			lval.float = num
+		case "duration":
+			duration, err := durationSeconds(str)
+			if err != nil {
+				l.Error(err.Error())
+				return EOF
+			}
+			lval.int = duration
 		case "stringLiteral":
 			// Pass string content to the parser.
-			lval.str = str[1 : len(str)-1]
+			lval.str = unescape(str[1 : len(str)-1])
 		default:
 			lval.str = str
 		}
@@ -148,9 +249,93 @@ func (l *AladinoLex) Lex(lval *AladinoSymType) int {
 }
 
 func (l *AladinoLex) Error(s string) {
-	fmt.Printf("syntax error on %s\n", l.input)
+	// Keep the first error since it is the one closest to the cause
+	if l.err != nil {
+		return
+	}
+
+	l.err = &ParseError{
+		Input:   l.source,
+		Offset:  l.tokenOffset,
+		Message: s,
+	}
+}
+
+var durationUnits = map[byte]int{
+	's': 1,
+	'm': 60,
+	'h': 60 * 60,
+	'd': 24 * 60 * 60,
+	'w': 7 * 24 * 60 * 60,
+}
+
+// durationSeconds returns the number of seconds in a duration literal (e.g. 3d).
+func durationSeconds(duration string) (int, error) {
+	amount, err := strconv.Atoi(duration[:len(duration)-1])
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration %v", duration)
+	}
+
+	return amount * durationUnits[duration[len(duration)-1]], nil
+}
+
+// skipSpacesAndComments skips whitespace (including new lines) and
+// comments, which start with # and go until the end of the line.
+func (l *AladinoLex) skipSpacesAndComments() {
+	for len(l.input) > 0 {
+		switch {
+		case isSpace(l.input[0]):
+			l.input = l.input[1:]
+		case l.input[0] == '#':
+			l.hasComments = true
+			end := strings.IndexByte(l.input, '\n')
+			if end == -1 {
+				end = len(l.input)
+			}
+			l.input = l.input[end:]
+		default:
+			return
+		}
+	}
 }
 
 func isSpace(c byte) bool {
-	return c == ' '
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
+
+var escapeSequences = map[byte]byte{
+	'n':  '\n',
+	't':  '\t',
+	'r':  '\r',
+	'\\': '\\',
+	'"':  '"',
+	'\'': '\'',
+}
+
+// unescape replaces the escape sequences in the content of a string literal.
+// Unknown escape sequences are kept as they are so that regular expressions
+// such as "new\(.*\)" keep working.
+func unescape(str string) string {
+	if !strings.Contains(str, "\\") {
+		return str
+	}
+
+	var sb strings.Builder
+	for i := 0; i < len(str); i++ {
+		if str[i] != '\\' {
+			sb.WriteByte(str[i])
+			continue
+		}
+
+		// The string literal regex guarantees that a \ is never the last character
+		i++
+		if c, ok := escapeSequences[str[i]]; ok {
+			sb.WriteByte(c)
+		} else {
+			sb.WriteByte('\\')
+			sb.WriteByte(str[i])
+		}
+	}
+
+	return sb.String()
 }
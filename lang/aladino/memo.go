@@ -0,0 +1,62 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package aladino
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Memo caches the results of the pure built-ins and of the conditions during a run
+// so that each one is computed only once. For instance, $totalCreatedPullRequests($author())
+// only reaches GitHub the first time it is evaluated.
+// The results of the built-ins are keyed by their call (e.g. $team("core")) and
+// the results of the conditions by their spec.
+type Memo map[string]Value
+
+// builtInMemoKey returns the key of a call to a built-in in the memo.
+// Calls with function arguments are not cached since functions cannot be compared.
+func builtInMemoKey(name string, args []Value) (string, bool) {
+	formattedArgs := make([]string, len(args))
+	for i, arg := range args {
+		if arg.Kind() == FUNCTION_VALUE {
+			return "", false
+		}
+
+		formattedArgs[i] = formatValue(arg)
+	}
+
+	return fmt.Sprintf("$%v(%v)", name, strings.Join(formattedArgs, ", ")), true
+}
+
+func conditionMemoKey(spec string) string {
+	return fmt.Sprintf("@condition:%v", spec)
+}
+
+// callBuiltIn calls a built-in function, reusing the previous result of the same call when the built-in is pure.
+func callBuiltIn(e Env, name string, fn *BuiltInFunction, args []Value) (Value, error) {
+	memo := e.GetMemo()
+	if !fn.Pure || memo == nil {
+		return fn.Code(e, args)
+	}
+
+	key, ok := builtInMemoKey(name, args)
+	if !ok {
+		return fn.Code(e, args)
+	}
+
+	if value, ok := memo[key]; ok {
+		return value, nil
+	}
+
+	value, err := fn.Code(e, args)
+	if err != nil {
+		return nil, err
+	}
+
+	memo[key] = value
+
+	return value, nil
+}
@@ -0,0 +1,100 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package aladino
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// mockCountingBuiltIns returns built-ins whose function $countCalls counts how many times it was called
+func mockCountingBuiltIns(calls *int, pure bool) *BuiltIns {
+	builtIns := MockBuiltIns()
+	builtIns.Functions["countCalls"] = &BuiltInFunction{
+		Type: BuildFunctionType([]Type{BuildStringType()}, BuildIntType()),
+		Code: func(e Env, args []Value) (Value, error) {
+			*calls++
+			return BuildIntValue(*calls), nil
+		},
+		Pure: pure,
+	}
+
+	return builtIns
+}
+
+func TestBuiltInMemoKey(t *testing.T) {
+	gotKey, ok := builtInMemoKey("team", []Value{BuildStringValue("core"), BuildIntValue(2)})
+
+	assert.True(t, ok)
+	assert.Equal(t, "$team(\"core\", 2)", gotKey)
+}
+
+func TestBuiltInMemoKey_WhenArgIsFunction(t *testing.T) {
+	_, ok := builtInMemoKey("filter", []Value{BuildArrayValue([]Value{}), BuildFunctionValue(nil)})
+
+	assert.False(t, ok)
+}
+
+func TestCallBuiltIn_WhenBuiltInIsPure(t *testing.T) {
+	calls := 0
+	mockedEnv, err := MockDefaultEnvWithBuiltIns(nil, nil, mockCountingBuiltIns(&calls, true))
+	if err != nil {
+		assert.FailNow(t, "MockDefaultEnvWithBuiltIns failed: %v", err)
+	}
+
+	expr, err := Parse(`$countCalls("a") + $countCalls("a") + $countCalls("b")`)
+	if err != nil {
+		assert.FailNow(t, "parse failed: %v", err)
+	}
+
+	gotVal, err := Eval(mockedEnv, expr)
+
+	assert.Nil(t, err)
+	assert.Equal(t, BuildIntValue(1+1+2), gotVal)
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, BuildIntValue(1), mockedEnv.GetMemo()["$countCalls(\"a\")"])
+}
+
+func TestCallBuiltIn_WhenBuiltInIsNotPure(t *testing.T) {
+	calls := 0
+	mockedEnv, err := MockDefaultEnvWithBuiltIns(nil, nil, mockCountingBuiltIns(&calls, false))
+	if err != nil {
+		assert.FailNow(t, "MockDefaultEnvWithBuiltIns failed: %v", err)
+	}
+
+	expr, err := Parse(`$countCalls("a") + $countCalls("a")`)
+	if err != nil {
+		assert.FailNow(t, "parse failed: %v", err)
+	}
+
+	gotVal, err := Eval(mockedEnv, expr)
+
+	assert.Nil(t, err)
+	assert.Equal(t, BuildIntValue(1+2), gotVal)
+	assert.Equal(t, 2, calls)
+	assert.Empty(t, mockedEnv.GetMemo())
+}
+
+func TestEvalExpr_WhenConditionIsCached(t *testing.T) {
+	calls := 0
+	mockedEnv, err := MockDefaultEnvWithBuiltIns(nil, nil, mockCountingBuiltIns(&calls, false))
+	if err != nil {
+		assert.FailNow(t, "MockDefaultEnvWithBuiltIns failed: %v", err)
+	}
+
+	spec := `$countCalls("a") == 1`
+
+	firstVal, err := EvalExpr(mockedEnv, "patch", spec)
+	assert.Nil(t, err)
+
+	secondVal, err := EvalExpr(mockedEnv, "patch", spec)
+	assert.Nil(t, err)
+
+	assert.True(t, firstVal)
+	assert.True(t, secondVal)
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, BuildBoolValue(true), mockedEnv.GetMemo()[conditionMemoKey(spec)])
+}
@@ -24,6 +24,9 @@ const DefaultMockPrNum = 6
 const DefaultMockPrOwner = "foobar"
 const DefaultMockPrRepoName = "default-mock-repo"
 
+// DefaultMockNow is the time told by the clock of the mocked Aladino Env
+var DefaultMockNow = time.Date(2022, 4, 5, 10, 0, 0, 0, time.UTC)
+
 var DefaultMockContext = context.Background()
 var DefaultMockCollector = collector.NewCollector("", "")
 
@@ -45,6 +48,7 @@ func GetDefaultMockPullRequestDetails() *github.PullRequest {
 		Body:      github.String("Please pull these awesome changes in!"),
 		URL:       github.String("https://foo.bar"),
 		CreatedAt: &prDate,
+		UpdatedAt: &prDate,
 		Comments:  github.Int(6),
 		Commits:   github.Int(5),
 		Number:    github.Int(prNum),
@@ -145,6 +149,14 @@ func GetDefaultMockPullRequestDetailsWith(pr *github.PullRequest) *github.PullRe
 		defaultPullRequest.Draft = pr.Draft
 	}
 
+	if pr.CreatedAt != nil {
+		defaultPullRequest.CreatedAt = pr.CreatedAt
+	}
+
+	if pr.UpdatedAt != nil {
+		defaultPullRequest.UpdatedAt = pr.UpdatedAt
+	}
+
 	return defaultPullRequest
 }
 
@@ -237,6 +249,7 @@ func mockEnvWith(prOwner string, prRepoName string, prNum int, client *github.Cl
 		pr,
 		eventPayload,
 		builtIns,
+		NewFixedClock(DefaultMockNow),
 	)
 
 	return env, err
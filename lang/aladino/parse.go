@@ -9,14 +9,41 @@ import (
 	"strings"
 )
 
+func init() {
+	// Report the unexpected token on syntax errors
+	AladinoErrorVerbose = true
+}
+
+// ParseError is returned when the input is not a valid Aladino expression.
+type ParseError struct {
+	Input string
+	// Offset of the token where the error was detected
+	Offset  int
+	Message string
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("parse error: failed to build AST on input %v", e.Input)
+}
+
 func Parse(input string) (Expr, error) {
+	expr, _, err := parse(input)
+	return expr, err
+}
+
+// parse builds the AST of the input along with the offset in the input of each expression.
+func parse(input string) (Expr, map[Expr]int, error) {
 	input = strings.TrimRight(input, "\n")
-	lex := &AladinoLex{input: input}
+	lex := newAladinoLex(input)
 	res := AladinoParse(lex)
 
+	if lex.err != nil {
+		return nil, nil, lex.err
+	}
+
 	if res != 0 {
-		return nil, fmt.Errorf("parse error: failed to build AST on input %v", input)
+		return nil, nil, &ParseError{Input: input, Offset: lex.tokenOffset}
 	}
 
-	return lex.ast, nil
+	return lex.ast, lex.positions, nil
 }
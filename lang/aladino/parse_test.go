@@ -34,3 +34,364 @@ func TestParse_WhenMultilineLine(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, wantExpr, gotExpr)
 }
+
+func TestParse_WhenExprSpansManyLines(t *testing.T) {
+	input := "$size() > 10\n\t&& $isDraft()\r\n"
+	wantExpr := BuildAndOp(
+		BuildGreaterThanOp(
+			BuildFunctionCall(BuildVariable("size"), []Expr{}),
+			BuildIntConst(10),
+		),
+		BuildFunctionCall(BuildVariable("isDraft"), []Expr{}),
+	)
+
+	gotExpr, err := Parse(input)
+	assert.Nil(t, err)
+	assert.Equal(t, wantExpr, gotExpr)
+}
+
+func TestParse_WhenComments(t *testing.T) {
+	input := `# large pull requests
+$size() > 10 # lines changed
+# drafts are not ready for review
+&& $isDraft()`
+	wantExpr := BuildAndOp(
+		BuildGreaterThanOp(
+			BuildFunctionCall(BuildVariable("size"), []Expr{}),
+			BuildIntConst(10),
+		),
+		BuildFunctionCall(BuildVariable("isDraft"), []Expr{}),
+	)
+
+	gotExpr, err := Parse(input)
+	assert.Nil(t, err)
+	assert.Equal(t, wantExpr, gotExpr)
+}
+
+func TestParse_WhenStringHasEscapeSequences(t *testing.T) {
+	input := `$comment("Please read the \"guidelines\":\n\t- small PRs\\no drafts")`
+	wantExpr := BuildFunctionCall(
+		BuildVariable("comment"),
+		[]Expr{BuildStringConst("Please read the \"guidelines\":\n\t- small PRs\\no drafts")},
+	)
+
+	gotExpr, err := Parse(input)
+	assert.Nil(t, err)
+	assert.Equal(t, wantExpr, gotExpr)
+}
+
+func TestParse_WhenStringHasUnknownEscapeSequences(t *testing.T) {
+	input := `$hasCodePattern("placeBet\(.*\)")`
+	wantExpr := BuildFunctionCall(
+		BuildVariable("hasCodePattern"),
+		[]Expr{BuildStringConst("placeBet\\(.*\\)")},
+	)
+
+	gotExpr, err := Parse(input)
+	assert.Nil(t, err)
+	assert.Equal(t, wantExpr, gotExpr)
+}
+
+func TestParse_WhenSingleQuotedString(t *testing.T) {
+	input := `$comment('Use "quotes" and \'escapes\' # not a comment')`
+	wantExpr := BuildFunctionCall(
+		BuildVariable("comment"),
+		[]Expr{BuildStringConst(`Use "quotes" and 'escapes' # not a comment`)},
+	)
+
+	gotExpr, err := Parse(input)
+	assert.Nil(t, err)
+	assert.Equal(t, wantExpr, gotExpr)
+}
+
+func TestParse_WhenSyntaxError(t *testing.T) {
+	input := `$addLabel("small"))`
+
+	wantErr := &ParseError{
+		Input:   input,
+		Offset:  18,
+		Message: "syntax error: unexpected ')'",
+	}
+
+	gotExpr, err := Parse(input)
+	assert.Nil(t, gotExpr)
+	assert.Equal(t, wantErr, err)
+}
+
+func TestParse_RecordsPositions(t *testing.T) {
+	input := `$size() > 10`
+
+	gotExpr, gotPositions, err := parse(input)
+	assert.Nil(t, err)
+
+	binaryOp := gotExpr.(*BinaryOp)
+	assert.Equal(t, 0, gotPositions[binaryOp])
+	assert.Equal(t, 0, gotPositions[binaryOp.lhs])
+	assert.Equal(t, 10, gotPositions[binaryOp.rhs])
+}
+
+func TestParse_WhenArithmeticOperators(t *testing.T) {
+	input := `-$additions() - 2 * 3 > 100`
+	wantExpr := BuildGreaterThanOp(
+		BuildSubOp(
+			BuildNegOp(BuildFunctionCall(BuildVariable("additions"), []Expr{})),
+			BuildMulOp(BuildIntConst(2), BuildIntConst(3)),
+		),
+		BuildIntConst(100),
+	)
+
+	gotExpr, err := Parse(input)
+	assert.Nil(t, err)
+	assert.Equal(t, wantExpr, gotExpr)
+}
+
+func TestParse_WhenFloats(t *testing.T) {
+	input := `$deletions() / $size() > 0.8 || 2e3 + .5 < 1.5E-2`
+	wantExpr := BuildOrOp(
+		BuildGreaterThanOp(
+			BuildDivOp(
+				BuildFunctionCall(BuildVariable("deletions"), []Expr{}),
+				BuildFunctionCall(BuildVariable("size"), []Expr{}),
+			),
+			BuildFloatConst(0.8),
+		),
+		BuildLessThanOp(
+			BuildAddOp(BuildFloatConst(2000), BuildFloatConst(0.5)),
+			BuildFloatConst(0.015),
+		),
+	)
+
+	gotExpr, err := Parse(input)
+	assert.Nil(t, err)
+	assert.Equal(t, wantExpr, gotExpr)
+}
+
+func TestParse_WhenNumberIsInvalid(t *testing.T) {
+	input := `$size() > 1e999`
+
+	gotExpr, err := Parse(input)
+
+	wantErr := &ParseError{
+		Input:   input,
+		Offset:  10,
+		Message: "invalid number 1e999",
+	}
+
+	assert.Nil(t, gotExpr)
+	assert.Equal(t, wantErr, err)
+}
+
+func TestParse_WhenMatchOperators(t *testing.T) {
+	input := `$title() =~ "^(feat|fix)(\\(.+\\))?: " && $title() !~ "WIP"`
+	wantExpr := BuildAndOp(
+		BuildMatchOp(
+			BuildFunctionCall(BuildVariable("title"), []Expr{}),
+			BuildStringConst(`^(feat|fix)(\(.+\))?: `),
+		),
+		BuildNotMatchOp(
+			BuildFunctionCall(BuildVariable("title"), []Expr{}),
+			BuildStringConst("WIP"),
+		),
+	)
+
+	gotExpr, err := Parse(input)
+	assert.Nil(t, err)
+	assert.Equal(t, wantExpr, gotExpr)
+}
+
+func TestParse_WhenInOperator(t *testing.T) {
+	input := `$author() in $group("owners") || $author() in ["john", "jane"]`
+	wantExpr := BuildOrOp(
+		BuildInOp(
+			BuildFunctionCall(BuildVariable("author"), []Expr{}),
+			BuildFunctionCall(BuildVariable("group"), []Expr{BuildStringConst("owners")}),
+		),
+		BuildInOp(
+			BuildFunctionCall(BuildVariable("author"), []Expr{}),
+			BuildArray([]Expr{BuildStringConst("john"), BuildStringConst("jane")}),
+		),
+	)
+
+	gotExpr, err := Parse(input)
+	assert.Nil(t, err)
+	assert.Equal(t, wantExpr, gotExpr)
+}
+
+func TestParse_WhenConditional(t *testing.T) {
+	input := `$assignReviewer($group("x"), if $size() > 100 then 2 else 1 + 1)`
+	wantExpr := BuildFunctionCall(
+		BuildVariable("assignReviewer"),
+		[]Expr{
+			BuildFunctionCall(BuildVariable("group"), []Expr{BuildStringConst("x")}),
+			BuildConditional(
+				BuildGreaterThanOp(BuildFunctionCall(BuildVariable("size"), []Expr{}), BuildIntConst(100)),
+				BuildIntConst(2),
+				BuildAddOp(BuildIntConst(1), BuildIntConst(1)),
+			),
+		},
+	)
+
+	gotExpr, err := Parse(input)
+	assert.Nil(t, err)
+	assert.Equal(t, wantExpr, gotExpr)
+}
+
+func TestParse_WhenFunctionNameStartsWithKeyword(t *testing.T) {
+	input := `$isDraft() && $ifPresent()`
+	wantExpr := BuildAndOp(
+		BuildFunctionCall(BuildVariable("isDraft"), []Expr{}),
+		BuildFunctionCall(BuildVariable("ifPresent"), []Expr{}),
+	)
+
+	gotExpr, err := Parse(input)
+	assert.Nil(t, err)
+	assert.Equal(t, wantExpr, gotExpr)
+}
+
+func TestParse_WhenNamedArguments(t *testing.T) {
+	input := `$assignReviewer($group("x"), total: if $size() > 100 then 2 else 1)`
+	wantExpr := BuildFunctionCall(
+		BuildVariable("assignReviewer"),
+		[]Expr{
+			BuildFunctionCall(BuildVariable("group"), []Expr{BuildStringConst("x")}),
+			BuildNamedArg(
+				"total",
+				BuildConditional(
+					BuildGreaterThanOp(BuildFunctionCall(BuildVariable("size"), []Expr{}), BuildIntConst(100)),
+					BuildIntConst(2),
+					BuildIntConst(1),
+				),
+			),
+		},
+	)
+
+	gotExpr, err := Parse(input)
+	assert.Nil(t, err)
+	assert.Equal(t, wantExpr, gotExpr)
+}
+
+func TestParse_WhenLambda(t *testing.T) {
+	input := `$filter($reviewers(), ($r: String => $startsWith($r, "bot")))`
+	wantExpr := BuildFunctionCall(
+		BuildVariable("filter"),
+		[]Expr{
+			BuildFunctionCall(BuildVariable("reviewers"), []Expr{}),
+			BuildLambda(
+				[]Expr{BuildTypedExpr(BuildVariable("r"), BuildStringType())},
+				BuildFunctionCall(
+					BuildVariable("startsWith"),
+					[]Expr{BuildVariable("r"), BuildStringConst("bot")},
+				),
+			),
+		},
+	)
+
+	gotExpr, err := Parse(input)
+	assert.Nil(t, err)
+	assert.Equal(t, wantExpr, gotExpr)
+}
+
+func TestParse_WhenLambdaHasManyParameters(t *testing.T) {
+	input := `($a: Int, $b: []Bool => $a)`
+	wantExpr := BuildLambda(
+		[]Expr{
+			BuildTypedExpr(BuildVariable("a"), BuildIntType()),
+			BuildTypedExpr(BuildVariable("b"), BuildArrayOfType(BuildBoolType())),
+		},
+		BuildVariable("a"),
+	)
+
+	gotExpr, err := Parse(input)
+	assert.Nil(t, err)
+	assert.Equal(t, wantExpr, gotExpr)
+}
+
+func TestParse_WhenLambdaParameterIsMap(t *testing.T) {
+	input := `($m: Map[[]String] => $m)`
+	wantExpr := BuildLambda(
+		[]Expr{BuildTypedExpr(BuildVariable("m"), BuildMapType(BuildArrayOfType(BuildStringType())))},
+		BuildVariable("m"),
+	)
+
+	gotExpr, err := Parse(input)
+	assert.Nil(t, err)
+	assert.Equal(t, wantExpr, gotExpr)
+}
+
+func TestParse_WhenLambdaParameterHasUnknownGenericType(t *testing.T) {
+	input := `($a: Set[String] => $a)`
+
+	gotExpr, err := Parse(input)
+
+	wantErr := &ParseError{
+		Input:   input,
+		Offset:  5,
+		Message: "unknown type Set",
+	}
+
+	assert.Nil(t, gotExpr)
+	assert.Equal(t, wantErr, err)
+}
+
+func TestParse_WhenLambdaParameterHasUnknownType(t *testing.T) {
+	input := `($a: Foo => $a)`
+
+	gotExpr, err := Parse(input)
+
+	wantErr := &ParseError{
+		Input:   input,
+		Offset:  5,
+		Message: "unknown type Foo",
+	}
+
+	assert.Nil(t, gotExpr)
+	assert.Equal(t, wantErr, err)
+}
+
+func TestParse_WhenDuration(t *testing.T) {
+	input := `$createdAt() < $now() - 3d - 12h`
+	wantExpr := BuildLessThanOp(
+		BuildFunctionCall(BuildVariable("createdAt"), []Expr{}),
+		BuildSubOp(
+			BuildSubOp(
+				BuildFunctionCall(BuildVariable("now"), []Expr{}),
+				BuildDurationConst(3*24*60*60),
+			),
+			BuildDurationConst(12*60*60),
+		),
+	)
+
+	gotExpr, err := Parse(input)
+	assert.Nil(t, err)
+	assert.Equal(t, wantExpr, gotExpr)
+}
+
+func TestParse_WhenDurationIsInvalid(t *testing.T) {
+	input := `1 + 99999999999999999999d`
+
+	gotExpr, err := Parse(input)
+
+	wantErr := &ParseError{
+		Input:   input,
+		Offset:  4,
+		Message: "invalid duration 99999999999999999999d",
+	}
+
+	assert.Nil(t, gotExpr)
+	assert.Equal(t, wantErr, err)
+}
+
+func TestParse_WhenTimestampIsInvalid(t *testing.T) {
+	input := `$createdAt() > 2022-02-30`
+
+	gotExpr, err := Parse(input)
+
+	wantErr := &ParseError{
+		Input:   input,
+		Offset:  15,
+		Message: "invalid timestamp 2022-02-30",
+	}
+
+	assert.Nil(t, gotExpr)
+	assert.Equal(t, wantErr, err)
+}
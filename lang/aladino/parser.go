@@ -7,19 +7,52 @@ package aladino
 
 import __yyfmt__ "fmt"
 
+import "fmt"
+
 var base int
 
 func setAST(l AladinoLexer, root Expr) {
 	l.(*AladinoLex).ast = root
 }
 
+func setFunctionDef(l AladinoLexer, def *FunctionDef) {
+	l.(*AladinoLex).functionDef = def
+}
+
+func setPos(l AladinoLexer, expr Expr, pos int) {
+	l.(*AladinoLex).positions[expr] = pos
+}
+
+// setParseError records an error on the symbol that starts at pos
+func setParseError(l AladinoLexer, pos int, message string) {
+	lex := l.(*AladinoLex)
+	lex.err = &ParseError{
+		Input:   lex.source,
+		Offset:  pos,
+		Message: message,
+	}
+}
+
+func buildNamedType(l AladinoLexer, name string, pos int) Type {
+	ty := BuildTypeFromName(name)
+	if ty == nil {
+		setParseError(l, pos, fmt.Sprintf("unknown type %v", name))
+	}
+	return ty
+}
+
 type AladinoSymType struct {
 	yys     int
 	str     string
 	int     int
+	float   float64
 	ast     Expr
 	astList []Expr
 	bool    bool
+	typ     Type
+	def     *FunctionDef
+	// offset of the first token of the symbol in the input
+	pos int
 }
 
 const TIMESTAMP = 57346
@@ -28,13 +61,24 @@ const IDENTIFIER = 57348
 const STRINGLITERAL = 57349
 const TK_CMPOP = 57350
 const NUMBER = 57351
-const TRUE = 57352
-const FALSE = 57353
-const TK_OR = 57354
-const TK_AND = 57355
-const TK_EQ = 57356
-const TK_NEQ = 57357
-const TK_NOT = 57358
+const DURATION = 57352
+const FLOAT = 57353
+const TRUE = 57354
+const FALSE = 57355
+const TK_ARROW = 57356
+const TK_IF = 57357
+const TK_THEN = 57358
+const TK_FUNCTION_DEF = 57359
+const TK_ELSE = 57360
+const TK_OR = 57361
+const TK_AND = 57362
+const TK_EQ = 57363
+const TK_NEQ = 57364
+const TK_MATCH = 57365
+const TK_NMATCH = 57366
+const TK_IN = 57367
+const TK_NOT = 57368
+const UMINUS = 57369
 
 var AladinoToknames = [...]string{
 	"$end",
@@ -46,19 +90,37 @@ var AladinoToknames = [...]string{
 	"STRINGLITERAL",
 	"TK_CMPOP",
 	"NUMBER",
+	"DURATION",
+	"FLOAT",
 	"TRUE",
 	"FALSE",
+	"TK_ARROW",
+	"TK_IF",
+	"TK_THEN",
+	"TK_FUNCTION_DEF",
+	"TK_ELSE",
 	"TK_OR",
 	"TK_AND",
 	"TK_EQ",
 	"TK_NEQ",
+	"TK_MATCH",
+	"TK_NMATCH",
+	"TK_IN",
+	"'+'",
+	"'-'",
+	"'*'",
+	"'/'",
+	"'%'",
 	"TK_NOT",
+	"UMINUS",
 	"'('",
 	"')'",
+	"':'",
+	"'='",
+	"','",
 	"'['",
 	"']'",
 	"'$'",
-	"','",
 }
 
 var AladinoStatenames = [...]string{}
@@ -69,7 +131,7 @@ const AladinoInitialStackSize = 16
 
 /*  start  of  programs  */
 
-var AladinoExca = [...]int{
+var AladinoExca = [...]int8{
 	-1, 1,
 	1, -1,
 	-2, 0,
@@ -77,74 +139,132 @@ var AladinoExca = [...]int{
 
 const AladinoPrivate = 57344
 
-const AladinoLast = 69
-
-var AladinoAct = [...]int{
-	20, 5, 6, 29, 8, 34, 7, 11, 12, 31,
-	22, 1, 0, 3, 4, 17, 9, 0, 10, 14,
-	13, 15, 16, 21, 2, 0, 0, 18, 19, 30,
-	0, 32, 33, 0, 0, 0, 0, 23, 24, 25,
-	26, 27, 17, 0, 0, 0, 14, 13, 15, 16,
-	17, 0, 28, 17, 14, 13, 15, 16, 13, 15,
-	16, 17, 0, 0, 0, 0, 0, 15, 16,
+const AladinoLast = 351
+
+var AladinoAct = [...]int8{
+	41, 2, 83, 74, 65, 33, 34, 35, 36, 40,
+	37, 72, 102, 84, 95, 62, 94, 88, 79, 43,
+	44, 45, 46, 47, 48, 49, 50, 51, 52, 53,
+	54, 55, 8, 9, 77, 13, 61, 10, 12, 11,
+	16, 17, 101, 6, 64, 85, 70, 70, 90, 89,
+	80, 87, 78, 64, 56, 5, 59, 67, 68, 4,
+	69, 7, 28, 29, 30, 76, 14, 86, 15, 60,
+	42, 32, 71, 73, 26, 27, 28, 29, 30, 1,
+	31, 66, 93, 92, 91, 39, 75, 0, 0, 76,
+	97, 0, 96, 98, 0, 0, 0, 99, 100, 0,
+	8, 9, 103, 13, 0, 10, 12, 11, 16, 17,
+	0, 6, 0, 3, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 5, 0, 0, 0, 4, 0, 7,
+	0, 22, 8, 9, 14, 13, 15, 10, 12, 11,
+	16, 17, 0, 6, 20, 21, 23, 24, 25, 26,
+	27, 28, 29, 30, 0, 5, 0, 0, 0, 4,
+	0, 7, 0, 0, 8, 9, 14, 13, 15, 10,
+	12, 11, 16, 17, 0, 6, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 5, 0, 0,
+	22, 4, 0, 7, 0, 0, 0, 0, 14, 0,
+	38, 19, 18, 20, 21, 23, 24, 25, 26, 27,
+	28, 29, 30, 22, 0, 0, 0, 0, 0, 63,
+	0, 0, 0, 0, 19, 18, 20, 21, 23, 24,
+	25, 26, 27, 28, 29, 30, 22, 0, 0, 82,
+	0, 0, 0, 0, 0, 0, 0, 19, 18, 20,
+	21, 23, 24, 25, 26, 27, 28, 29, 30, 22,
+	0, 0, 58, 0, 0, 0, 0, 0, 0, 81,
+	19, 18, 20, 21, 23, 24, 25, 26, 27, 28,
+	29, 30, 22, 0, 0, 0, 0, 0, 0, 0,
+	57, 0, 0, 19, 18, 20, 21, 23, 24, 25,
+	26, 27, 28, 29, 30, 22, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 19, 18, 20, 21,
+	23, 24, 25, 26, 27, 28, 29, 30, 22, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	18, 20, 21, 23, 24, 25, 26, 27, 28, 29,
+	30,
 }
 
-var AladinoPact = [...]int{
-	-3, -1000, 42, -3, -3, -1000, -1000, -1000, -1000, -3,
-	4, -1000, -1000, -3, -3, -3, -3, -3, -1000, 34,
-	-17, 7, -8, 53, 45, -1000, -1000, -1000, -1000, -1000,
-	-3, -3, -1000, -13, -1000,
+var AladinoPact = [...]int16{
+	96, -1000, 297, 65, 128, 128, 128, 160, -1000, -1000,
+	-1000, -1000, -1000, -1000, 128, 64, -1000, -1000, 128, 128,
+	128, 128, 128, 128, 128, 128, 128, 128, 128, 128,
+	128, -1000, 21, -1000, -1000, 274, 228, 42, 63, -1,
+	-24, 182, 20, 123, 320, 48, 48, 48, 48, 48,
+	48, 34, 34, -1000, -1000, -1000, 51, 128, -1000, 128,
+	11, -29, -1000, 128, 28, 18, -19, 15, 251, 205,
+	7, -1000, 61, -1000, 17, -20, 297, 14, 13, 51,
+	7, 128, -1000, -1000, -22, -25, 12, -1000, 28, 128,
+	7, -1000, -1000, 297, 7, 7, -1000, 297, 6, -27,
+	-1000, 128, -1000, 297,
 }
 
-var AladinoPgo = [...]int{
-	0, 23, 0, 11,
+var AladinoPgo = [...]int8{
+	0, 0, 9, 3, 10, 4, 86, 85, 81, 2,
+	80, 79,
 }
 
-var AladinoR1 = [...]int{
-	0, 3, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 2, 2,
-	2,
+var AladinoR1 = [...]int8{
+	0, 11, 11, 10, 5, 5, 5, 8, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 2, 2, 2,
+	3, 3, 3, 6, 6, 4, 4, 7, 9, 9,
+	9,
 }
 
-var AladinoR2 = [...]int{
-	0, 1, 2, 3, 3, 3, 3, 3, 3, 1,
-	1, 1, 1, 3, 2, 1, 1, 5, 3, 1,
-	0,
+var AladinoR2 = [...]int8{
+	0, 1, 2, 8, 3, 1, 0, 3, 2, 2,
+	3, 3, 3, 3, 3, 3, 3, 3, 6, 3,
+	3, 3, 3, 3, 3, 5, 1, 1, 1, 1,
+	1, 1, 3, 2, 1, 1, 5, 3, 1, 0,
+	3, 1, 0, 1, 3, 3, 1, 4, 1, 3,
+	4,
 }
 
-var AladinoChk = [...]int{
-	-1000, -3, -1, 16, 17, 4, 5, 9, 7, 19,
-	21, 10, 11, 13, 12, 14, 15, 8, -1, -1,
-	-2, -1, 6, -1, -1, -1, -1, -1, 18, 20,
-	22, 17, -2, -2, 18,
+var AladinoChk = [...]int16{
+	-1000, -11, -1, 17, 31, 27, 15, 33, 4, 5,
+	9, 11, 10, 7, 38, 40, 12, 13, 20, 19,
+	21, 22, 8, 23, 24, 25, 26, 27, 28, 29,
+	30, -10, 6, -1, -1, -1, -1, -4, 40, -7,
+	-2, -1, 6, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, 33, 16, 34, 14,
+	6, 37, 39, 37, 33, -5, -8, 6, -1, -1,
+	35, -4, 40, -2, -3, -6, -1, 6, 34, 37,
+	35, 18, 34, -9, 6, 38, 6, 34, 37, 35,
+	35, -5, -9, -1, 38, 39, -3, -1, -9, -9,
+	-9, 36, 39, -1,
 }
 
-var AladinoDef = [...]int{
-	0, -2, 1, 0, 0, 9, 10, 11, 12, 20,
-	0, 15, 16, 0, 0, 0, 0, 0, 2, 0,
-	0, 19, 14, 3, 4, 5, 6, 7, 8, 13,
-	20, 20, 18, 0, 17,
+var AladinoDef = [...]int8{
+	0, -2, 1, 0, 0, 0, 0, 0, 26, 27,
+	28, 29, 30, 31, 39, 0, 34, 35, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 2, 0, 8, 9, 0, 0, 0, 0, 46,
+	0, 38, 33, 10, 11, 12, 13, 14, 15, 16,
+	17, 19, 20, 21, 22, 23, 6, 0, 24, 0,
+	33, 0, 32, 39, 42, 0, 5, 0, 0, 0,
+	0, 45, 0, 37, 0, 41, 43, 0, 0, 6,
+	0, 0, 25, 47, 48, 0, 0, 36, 42, 0,
+	0, 4, 7, 18, 0, 0, 40, 44, 0, 0,
+	49, 0, 50, 3,
 }
 
-var AladinoTok1 = [...]int{
+var AladinoTok1 = [...]int8{
 	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 21, 3, 3, 3,
-	17, 18, 3, 3, 22, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 40, 30, 3, 3,
+	33, 34, 28, 26, 37, 27, 3, 29, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 35, 3,
+	3, 36, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 19, 3, 20,
+	3, 38, 3, 39,
 }
 
-var AladinoTok2 = [...]int{
+var AladinoTok2 = [...]int8{
 	2, 3, 4, 5, 6, 7, 8, 9, 10, 11,
-	12, 13, 14, 15, 16,
+	12, 13, 14, 15, 16, 17, 18, 19, 20, 21,
+	22, 23, 24, 25, 31, 32,
 }
 
-var AladinoTok3 = [...]int{
+var AladinoTok3 = [...]int8{
 	0,
 }
 
@@ -224,9 +344,9 @@ func AladinoErrorMessage(state, lookAhead int) string {
 	expected := make([]int, 0, 4)
 
 	// Look for shiftable tokens.
-	base := AladinoPact[state]
+	base := int(AladinoPact[state])
 	for tok := TOKSTART; tok-1 < len(AladinoToknames); tok++ {
-		if n := base + tok; n >= 0 && n < AladinoLast && AladinoChk[AladinoAct[n]] == tok {
+		if n := base + tok; n >= 0 && n < AladinoLast && int(AladinoChk[int(AladinoAct[n])]) == tok {
 			if len(expected) == cap(expected) {
 				return res
 			}
@@ -236,13 +356,13 @@ func AladinoErrorMessage(state, lookAhead int) string {
 
 	if AladinoDef[state] == -2 {
 		i := 0
-		for AladinoExca[i] != -1 || AladinoExca[i+1] != state {
+		for AladinoExca[i] != -1 || int(AladinoExca[i+1]) != state {
 			i += 2
 		}
 
 		// Look for tokens that we accept or reduce.
 		for i += 2; AladinoExca[i] >= 0; i += 2 {
-			tok := AladinoExca[i]
+			tok := int(AladinoExca[i])
 			if tok < TOKSTART || AladinoExca[i+1] == 0 {
 				continue
 			}
@@ -273,30 +393,30 @@ func Aladinolex1(lex AladinoLexer, lval *AladinoSymType) (char, token int) {
 	token = 0
 	char = lex.Lex(lval)
 	if char <= 0 {
-		token = AladinoTok1[0]
+		token = int(AladinoTok1[0])
 		goto out
 	}
 	if char < len(AladinoTok1) {
-		token = AladinoTok1[char]
+		token = int(AladinoTok1[char])
 		goto out
 	}
 	if char >= AladinoPrivate {
 		if char < AladinoPrivate+len(AladinoTok2) {
-			token = AladinoTok2[char-AladinoPrivate]
+			token = int(AladinoTok2[char-AladinoPrivate])
 			goto out
 		}
 	}
 	for i := 0; i < len(AladinoTok3); i += 2 {
-		token = AladinoTok3[i+0]
+		token = int(AladinoTok3[i+0])
 		if token == char {
-			token = AladinoTok3[i+1]
+			token = int(AladinoTok3[i+1])
 			goto out
 		}
 	}
 
 out:
 	if token == 0 {
-		token = AladinoTok2[1] /* unknown char */
+		token = int(AladinoTok2[1]) /* unknown char */
 	}
 	if AladinoDebug >= 3 {
 		__yyfmt__.Printf("lex %s(%d)\n", AladinoTokname(token), uint(char))
@@ -351,7 +471,7 @@ Aladinostack:
 	AladinoS[Aladinop].yys = Aladinostate
 
 Aladinonewstate:
-	Aladinon = AladinoPact[Aladinostate]
+	Aladinon = int(AladinoPact[Aladinostate])
 	if Aladinon <= AladinoFlag {
 		goto Aladinodefault /* simple state */
 	}
@@ -362,8 +482,8 @@ Aladinonewstate:
 	if Aladinon < 0 || Aladinon >= AladinoLast {
 		goto Aladinodefault
 	}
-	Aladinon = AladinoAct[Aladinon]
-	if AladinoChk[Aladinon] == Aladinotoken { /* valid shift */
+	Aladinon = int(AladinoAct[Aladinon])
+	if int(AladinoChk[Aladinon]) == Aladinotoken { /* valid shift */
 		Aladinorcvr.char = -1
 		Aladinotoken = -1
 		AladinoVAL = Aladinorcvr.lval
@@ -376,7 +496,7 @@ Aladinonewstate:
 
 Aladinodefault:
 	/* default state action */
-	Aladinon = AladinoDef[Aladinostate]
+	Aladinon = int(AladinoDef[Aladinostate])
 	if Aladinon == -2 {
 		if Aladinorcvr.char < 0 {
 			Aladinorcvr.char, Aladinotoken = Aladinolex1(Aladinolex, &Aladinorcvr.lval)
@@ -385,18 +505,18 @@ Aladinodefault:
 		/* look through exception table */
 		xi := 0
 		for {
-			if AladinoExca[xi+0] == -1 && AladinoExca[xi+1] == Aladinostate {
+			if AladinoExca[xi+0] == -1 && int(AladinoExca[xi+1]) == Aladinostate {
 				break
 			}
 			xi += 2
 		}
 		for xi += 2; ; xi += 2 {
-			Aladinon = AladinoExca[xi+0]
+			Aladinon = int(AladinoExca[xi+0])
 			if Aladinon < 0 || Aladinon == Aladinotoken {
 				break
 			}
 		}
-		Aladinon = AladinoExca[xi+1]
+		Aladinon = int(AladinoExca[xi+1])
 		if Aladinon < 0 {
 			goto ret0
 		}
@@ -418,10 +538,10 @@ Aladinodefault:
 
 			/* find a state where "error" is a legal shift action */
 			for Aladinop >= 0 {
-				Aladinon = AladinoPact[AladinoS[Aladinop].yys] + AladinoErrCode
+				Aladinon = int(AladinoPact[AladinoS[Aladinop].yys]) + AladinoErrCode
 				if Aladinon >= 0 && Aladinon < AladinoLast {
-					Aladinostate = AladinoAct[Aladinon] /* simulate a shift of "error" */
-					if AladinoChk[Aladinostate] == AladinoErrCode {
+					Aladinostate = int(AladinoAct[Aladinon]) /* simulate a shift of "error" */
+					if int(AladinoChk[Aladinostate]) == AladinoErrCode {
 						goto Aladinostack
 					}
 				}
@@ -457,7 +577,7 @@ Aladinodefault:
 	Aladinopt := Aladinop
 	_ = Aladinopt // guard against "declared and not used"
 
-	Aladinop -= AladinoR2[Aladinon]
+	Aladinop -= int(AladinoR2[Aladinon])
 	// Aladinop is now the index of $0. Perform the default action. Iff the
 	// reduced production is ε, $1 is possibly out of range.
 	if Aladinop+1 >= len(AladinoS) {
@@ -468,16 +588,16 @@ Aladinodefault:
 	AladinoVAL = AladinoS[Aladinop+1]
 
 	/* consult goto table to find next state */
-	Aladinon = AladinoR1[Aladinon]
-	Aladinog := AladinoPgo[Aladinon]
+	Aladinon = int(AladinoR1[Aladinon])
+	Aladinog := int(AladinoPgo[Aladinon])
 	Aladinoj := Aladinog + AladinoS[Aladinop].yys + 1
 
 	if Aladinoj >= AladinoLast {
-		Aladinostate = AladinoAct[Aladinog]
+		Aladinostate = int(AladinoAct[Aladinog])
 	} else {
-		Aladinostate = AladinoAct[Aladinoj]
-		if AladinoChk[Aladinostate] != -Aladinon {
-			Aladinostate = AladinoAct[Aladinog]
+		Aladinostate = int(AladinoAct[Aladinoj])
+		if int(AladinoChk[Aladinostate]) != -Aladinon {
+			Aladinostate = int(AladinoAct[Aladinog])
 		}
 	}
 	// dummy call; replaced with literal code
@@ -491,98 +611,297 @@ Aladinodefault:
 	case 2:
 		AladinoDollar = AladinoS[Aladinopt-2 : Aladinopt+1]
 		{
-			AladinoVAL.ast = BuildNotOp(AladinoDollar[2].ast)
+			setFunctionDef(Aladinolex, AladinoDollar[2].def)
 		}
 	case 3:
+		AladinoDollar = AladinoS[Aladinopt-8 : Aladinopt+1]
+		{
+			AladinoVAL.def = BuildFunctionDef(AladinoDollar[1].str, AladinoDollar[3].astList, AladinoDollar[6].typ, AladinoDollar[8].ast)
+		}
+	case 4:
+		AladinoDollar = AladinoS[Aladinopt-3 : Aladinopt+1]
+		{
+			AladinoVAL.astList = append([]Expr{AladinoDollar[1].ast}, AladinoDollar[3].astList...)
+		}
+	case 5:
+		AladinoDollar = AladinoS[Aladinopt-1 : Aladinopt+1]
+		{
+			AladinoVAL.astList = []Expr{AladinoDollar[1].ast}
+		}
+	case 6:
+		AladinoDollar = AladinoS[Aladinopt-0 : Aladinopt+1]
+		{
+			AladinoVAL.astList = []Expr{}
+		}
+	case 7:
+		AladinoDollar = AladinoS[Aladinopt-3 : Aladinopt+1]
+		{
+			param := BuildVariable(AladinoDollar[1].str)
+			setPos(Aladinolex, param, AladinoDollar[1].pos)
+			AladinoVAL.ast = BuildTypedExpr(param, AladinoDollar[3].typ)
+			setPos(Aladinolex, AladinoVAL.ast, AladinoDollar[1].pos)
+		}
+	case 8:
+		AladinoDollar = AladinoS[Aladinopt-2 : Aladinopt+1]
+		{
+			AladinoVAL.ast = BuildNotOp(AladinoDollar[2].ast)
+			setPos(Aladinolex, AladinoVAL.ast, AladinoDollar[1].pos)
+		}
+	case 9:
+		AladinoDollar = AladinoS[Aladinopt-2 : Aladinopt+1]
+		{
+			AladinoVAL.ast = BuildNegOp(AladinoDollar[2].ast)
+			setPos(Aladinolex, AladinoVAL.ast, AladinoDollar[1].pos)
+		}
+	case 10:
 		AladinoDollar = AladinoS[Aladinopt-3 : Aladinopt+1]
 		{
 			AladinoVAL.ast = BuildAndOp(AladinoDollar[1].ast, AladinoDollar[3].ast)
+			setPos(Aladinolex, AladinoVAL.ast, AladinoDollar[1].pos)
 		}
-	case 4:
+	case 11:
 		AladinoDollar = AladinoS[Aladinopt-3 : Aladinopt+1]
 		{
 			AladinoVAL.ast = BuildOrOp(AladinoDollar[1].ast, AladinoDollar[3].ast)
+			setPos(Aladinolex, AladinoVAL.ast, AladinoDollar[1].pos)
 		}
-	case 5:
+	case 12:
 		AladinoDollar = AladinoS[Aladinopt-3 : Aladinopt+1]
 		{
 			AladinoVAL.ast = BuildEqOp(AladinoDollar[1].ast, AladinoDollar[3].ast)
+			setPos(Aladinolex, AladinoVAL.ast, AladinoDollar[1].pos)
 		}
-	case 6:
+	case 13:
 		AladinoDollar = AladinoS[Aladinopt-3 : Aladinopt+1]
 		{
 			AladinoVAL.ast = BuildNeqOp(AladinoDollar[1].ast, AladinoDollar[3].ast)
+			setPos(Aladinolex, AladinoVAL.ast, AladinoDollar[1].pos)
 		}
-	case 7:
+	case 14:
 		AladinoDollar = AladinoS[Aladinopt-3 : Aladinopt+1]
 		{
 			AladinoVAL.ast = BuildCmpOp(AladinoDollar[1].ast, AladinoDollar[2].str, AladinoDollar[3].ast)
+			setPos(Aladinolex, AladinoVAL.ast, AladinoDollar[1].pos)
 		}
-	case 8:
+	case 15:
+		AladinoDollar = AladinoS[Aladinopt-3 : Aladinopt+1]
+		{
+			AladinoVAL.ast = BuildMatchOp(AladinoDollar[1].ast, AladinoDollar[3].ast)
+			setPos(Aladinolex, AladinoVAL.ast, AladinoDollar[1].pos)
+		}
+	case 16:
+		AladinoDollar = AladinoS[Aladinopt-3 : Aladinopt+1]
+		{
+			AladinoVAL.ast = BuildNotMatchOp(AladinoDollar[1].ast, AladinoDollar[3].ast)
+			setPos(Aladinolex, AladinoVAL.ast, AladinoDollar[1].pos)
+		}
+	case 17:
+		AladinoDollar = AladinoS[Aladinopt-3 : Aladinopt+1]
+		{
+			AladinoVAL.ast = BuildInOp(AladinoDollar[1].ast, AladinoDollar[3].ast)
+			setPos(Aladinolex, AladinoVAL.ast, AladinoDollar[1].pos)
+		}
+	case 18:
+		AladinoDollar = AladinoS[Aladinopt-6 : Aladinopt+1]
+		{
+			AladinoVAL.ast = BuildConditional(AladinoDollar[2].ast, AladinoDollar[4].ast, AladinoDollar[6].ast)
+			setPos(Aladinolex, AladinoVAL.ast, AladinoDollar[1].pos)
+		}
+	case 19:
+		AladinoDollar = AladinoS[Aladinopt-3 : Aladinopt+1]
+		{
+			AladinoVAL.ast = BuildAddOp(AladinoDollar[1].ast, AladinoDollar[3].ast)
+			setPos(Aladinolex, AladinoVAL.ast, AladinoDollar[1].pos)
+		}
+	case 20:
+		AladinoDollar = AladinoS[Aladinopt-3 : Aladinopt+1]
+		{
+			AladinoVAL.ast = BuildSubOp(AladinoDollar[1].ast, AladinoDollar[3].ast)
+			setPos(Aladinolex, AladinoVAL.ast, AladinoDollar[1].pos)
+		}
+	case 21:
+		AladinoDollar = AladinoS[Aladinopt-3 : Aladinopt+1]
+		{
+			AladinoVAL.ast = BuildMulOp(AladinoDollar[1].ast, AladinoDollar[3].ast)
+			setPos(Aladinolex, AladinoVAL.ast, AladinoDollar[1].pos)
+		}
+	case 22:
+		AladinoDollar = AladinoS[Aladinopt-3 : Aladinopt+1]
+		{
+			AladinoVAL.ast = BuildDivOp(AladinoDollar[1].ast, AladinoDollar[3].ast)
+			setPos(Aladinolex, AladinoVAL.ast, AladinoDollar[1].pos)
+		}
+	case 23:
+		AladinoDollar = AladinoS[Aladinopt-3 : Aladinopt+1]
+		{
+			AladinoVAL.ast = BuildModOp(AladinoDollar[1].ast, AladinoDollar[3].ast)
+			setPos(Aladinolex, AladinoVAL.ast, AladinoDollar[1].pos)
+		}
+	case 24:
 		AladinoDollar = AladinoS[Aladinopt-3 : Aladinopt+1]
 		{
 			AladinoVAL.ast = AladinoDollar[2].ast
 		}
-	case 9:
+	case 25:
+		AladinoDollar = AladinoS[Aladinopt-5 : Aladinopt+1]
+		{
+			AladinoVAL.ast = BuildLambda(AladinoDollar[2].astList, AladinoDollar[4].ast)
+			setPos(Aladinolex, AladinoVAL.ast, AladinoDollar[1].pos)
+		}
+	case 26:
 		AladinoDollar = AladinoS[Aladinopt-1 : Aladinopt+1]
 		{
-			AladinoVAL.ast = BuildTimeConst(AladinoDollar[1].str)
+			timeConst, err := BuildTimeConst(AladinoDollar[1].str)
+			if err != nil {
+				setParseError(Aladinolex, AladinoDollar[1].pos, err.Error())
+				return 1
+			}
+			AladinoVAL.ast = timeConst
+			setPos(Aladinolex, AladinoVAL.ast, AladinoDollar[1].pos)
 		}
-	case 10:
+	case 27:
 		AladinoDollar = AladinoS[Aladinopt-1 : Aladinopt+1]
 		{
-			AladinoVAL.ast = BuildRelativeTimeConst(AladinoDollar[1].str)
+			relativeTimeConst, err := BuildRelativeTimeConst(AladinoDollar[1].str)
+			if err != nil {
+				setParseError(Aladinolex, AladinoDollar[1].pos, err.Error())
+				return 1
+			}
+			AladinoVAL.ast = relativeTimeConst
+			setPos(Aladinolex, AladinoVAL.ast, AladinoDollar[1].pos)
 		}
-	case 11:
+	case 28:
 		AladinoDollar = AladinoS[Aladinopt-1 : Aladinopt+1]
 		{
 			AladinoVAL.ast = BuildIntConst(AladinoDollar[1].int)
+			setPos(Aladinolex, AladinoVAL.ast, AladinoDollar[1].pos)
 		}
-	case 12:
+	case 29:
+		AladinoDollar = AladinoS[Aladinopt-1 : Aladinopt+1]
+		{
+			AladinoVAL.ast = BuildFloatConst(AladinoDollar[1].float)
+			setPos(Aladinolex, AladinoVAL.ast, AladinoDollar[1].pos)
+		}
+	case 30:
+		AladinoDollar = AladinoS[Aladinopt-1 : Aladinopt+1]
+		{
+			AladinoVAL.ast = BuildDurationConst(AladinoDollar[1].int)
+			setPos(Aladinolex, AladinoVAL.ast, AladinoDollar[1].pos)
+		}
+	case 31:
 		AladinoDollar = AladinoS[Aladinopt-1 : Aladinopt+1]
 		{
 			AladinoVAL.ast = BuildStringConst(AladinoDollar[1].str)
+			setPos(Aladinolex, AladinoVAL.ast, AladinoDollar[1].pos)
 		}
-	case 13:
+	case 32:
 		AladinoDollar = AladinoS[Aladinopt-3 : Aladinopt+1]
 		{
 			AladinoVAL.ast = BuildArray(AladinoDollar[2].astList)
+			setPos(Aladinolex, AladinoVAL.ast, AladinoDollar[1].pos)
 		}
-	case 14:
+	case 33:
 		AladinoDollar = AladinoS[Aladinopt-2 : Aladinopt+1]
 		{
 			AladinoVAL.ast = BuildVariable(AladinoDollar[2].str)
+			setPos(Aladinolex, AladinoVAL.ast, AladinoDollar[1].pos)
 		}
-	case 15:
+	case 34:
 		AladinoDollar = AladinoS[Aladinopt-1 : Aladinopt+1]
 		{
 			AladinoVAL.ast = BuildBoolConst(true)
+			setPos(Aladinolex, AladinoVAL.ast, AladinoDollar[1].pos)
 		}
-	case 16:
+	case 35:
 		AladinoDollar = AladinoS[Aladinopt-1 : Aladinopt+1]
 		{
 			AladinoVAL.ast = BuildBoolConst(false)
+			setPos(Aladinolex, AladinoVAL.ast, AladinoDollar[1].pos)
 		}
-	case 17:
+	case 36:
 		AladinoDollar = AladinoS[Aladinopt-5 : Aladinopt+1]
 		{
-			AladinoVAL.ast = BuildFunctionCall(BuildVariable(AladinoDollar[2].str), AladinoDollar[4].astList)
+			name := BuildVariable(AladinoDollar[2].str)
+			setPos(Aladinolex, name, AladinoDollar[1].pos)
+			AladinoVAL.ast = BuildFunctionCall(name, AladinoDollar[4].astList)
+			setPos(Aladinolex, AladinoVAL.ast, AladinoDollar[1].pos)
 		}
-	case 18:
+	case 37:
 		AladinoDollar = AladinoS[Aladinopt-3 : Aladinopt+1]
 		{
 			AladinoVAL.astList = append([]Expr{AladinoDollar[1].ast}, AladinoDollar[3].astList...)
 		}
-	case 19:
+	case 38:
 		AladinoDollar = AladinoS[Aladinopt-1 : Aladinopt+1]
 		{
 			AladinoVAL.astList = []Expr{AladinoDollar[1].ast}
 		}
-	case 20:
+	case 39:
 		AladinoDollar = AladinoS[Aladinopt-0 : Aladinopt+1]
 		{
 			AladinoVAL.astList = []Expr{}
 		}
+	case 40:
+		AladinoDollar = AladinoS[Aladinopt-3 : Aladinopt+1]
+		{
+			AladinoVAL.astList = append([]Expr{AladinoDollar[1].ast}, AladinoDollar[3].astList...)
+		}
+	case 41:
+		AladinoDollar = AladinoS[Aladinopt-1 : Aladinopt+1]
+		{
+			AladinoVAL.astList = []Expr{AladinoDollar[1].ast}
+		}
+	case 42:
+		AladinoDollar = AladinoS[Aladinopt-0 : Aladinopt+1]
+		{
+			AladinoVAL.astList = []Expr{}
+		}
+	case 44:
+		AladinoDollar = AladinoS[Aladinopt-3 : Aladinopt+1]
+		{
+			AladinoVAL.ast = BuildNamedArg(AladinoDollar[1].str, AladinoDollar[3].ast)
+			setPos(Aladinolex, AladinoVAL.ast, AladinoDollar[1].pos)
+		}
+	case 45:
+		AladinoDollar = AladinoS[Aladinopt-3 : Aladinopt+1]
+		{
+			AladinoVAL.astList = append([]Expr{AladinoDollar[1].ast}, AladinoDollar[3].astList...)
+		}
+	case 46:
+		AladinoDollar = AladinoS[Aladinopt-1 : Aladinopt+1]
+		{
+			AladinoVAL.astList = []Expr{AladinoDollar[1].ast}
+		}
+	case 47:
+		AladinoDollar = AladinoS[Aladinopt-4 : Aladinopt+1]
+		{
+			param := BuildVariable(AladinoDollar[2].str)
+			setPos(Aladinolex, param, AladinoDollar[1].pos)
+			AladinoVAL.ast = BuildTypedExpr(param, AladinoDollar[4].typ)
+			setPos(Aladinolex, AladinoVAL.ast, AladinoDollar[1].pos)
+		}
+	case 48:
+		AladinoDollar = AladinoS[Aladinopt-1 : Aladinopt+1]
+		{
+			AladinoVAL.typ = buildNamedType(Aladinolex, AladinoDollar[1].str, AladinoDollar[1].pos)
+			if AladinoVAL.typ == nil {
+				return 1
+			}
+		}
+	case 49:
+		AladinoDollar = AladinoS[Aladinopt-3 : Aladinopt+1]
+		{
+			AladinoVAL.typ = BuildArrayOfType(AladinoDollar[3].typ)
+		}
+	case 50:
+		AladinoDollar = AladinoS[Aladinopt-4 : Aladinopt+1]
+		{
+			if AladinoDollar[1].str != "Map" {
+				setParseError(Aladinolex, AladinoDollar[1].pos, fmt.Sprintf("unknown type %v", AladinoDollar[1].str))
+				return 1
+			}
+			AladinoVAL.typ = BuildMapType(AladinoDollar[3].typ)
+		}
 	}
 	goto Aladinostack /* stack new state and value */
 }
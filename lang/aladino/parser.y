@@ -5,11 +5,39 @@
 
 package aladino
 
+import "fmt"
+
 var base int
 
 func setAST(l AladinoLexer, root Expr) {
     l.(*AladinoLex).ast = root
 }
+
+func setFunctionDef(l AladinoLexer, def *FunctionDef) {
+    l.(*AladinoLex).functionDef = def
+}
+
+func setPos(l AladinoLexer, expr Expr, pos int) {
+    l.(*AladinoLex).positions[expr] = pos
+}
+
+// setParseError records an error on the symbol that starts at pos
+func setParseError(l AladinoLexer, pos int, message string) {
+    lex := l.(*AladinoLex)
+    lex.err = &ParseError{
+        Input:   lex.source,
+        Offset:  pos,
+        Message: message,
+    }
+}
+
+func buildNamedType(l AladinoLexer, name string, pos int) Type {
+    ty := BuildTypeFromName(name)
+    if ty == nil {
+        setParseError(l, pos, fmt.Sprintf("unknown type %v", name))
+    }
+    return ty
+}
 %}
 
 // fields inside this union end up as the fields in a structure known
@@ -17,51 +45,123 @@ func setAST(l AladinoLexer, root Expr) {
 %union{
     str string
     int int
+    float float64
     ast Expr
     astList []Expr
     bool bool
+    typ Type
+    def *FunctionDef
+    // offset of the first token of the symbol in the input
+    pos int
 }
 
 // any non-terminal which returns a value needs a type, which is
 // really a field name in the above union struct
 %type <ast> expr
-%type <astList> expr_list
+%type <astList> expr_list arg_list lambda_params function_params
+%type <ast> arg lambda_param function_param
+%type <typ> type
+%type <def> function_def
 
 // same for terminals
 %token <str> TIMESTAMP RELATIVETIMESTAMP IDENTIFIER STRINGLITERAL TK_CMPOP 
-%token <int> NUMBER
+%token <int> NUMBER DURATION
+%token <float> FLOAT
 %token <bool> TRUE
 %token <bool> FALSE
+%token TK_ARROW TK_IF TK_THEN
+// TK_FUNCTION_DEF is never in the input, the lexer starts with it to parse a function definition
+%token TK_FUNCTION_DEF
 
+%nonassoc TK_ELSE
 %left TK_OR
 %left TK_AND
-%left TK_EQ TK_NEQ TK_CMPOP
-%left TK_NOT
+%left TK_EQ TK_NEQ TK_CMPOP TK_MATCH TK_NMATCH TK_IN
+%left '+' '-'
+%left '*' '/' '%'
+%left TK_NOT UMINUS
 
 %%
 
 prog :
       expr { setAST(Aladinolex, $1) }
+    | TK_FUNCTION_DEF function_def { setFunctionDef(Aladinolex, $2) }
+;
+
+function_def :
+      IDENTIFIER '(' function_params ')' ':' type '=' expr { $$ = BuildFunctionDef($1, $3, $6, $8) }
+;
+
+function_params :
+      function_param ',' function_params { $$ = append([]Expr{$1}, $3...) }
+    | function_param                     { $$ = []Expr{$1} }
+    |                                    { $$ = []Expr{} }
+;
+
+function_param :
+      IDENTIFIER ':' type
+        {
+            param := BuildVariable($1)
+            setPos(Aladinolex, param, $<pos>1)
+            $$ = BuildTypedExpr(param, $3)
+            setPos(Aladinolex, $$, $<pos>1)
+        }
 ;
 
 expr :
-      TK_NOT expr        { $$ = BuildNotOp($2) }
-    | expr TK_AND expr   { $$ = BuildAndOp($1, $3) }
-    | expr TK_OR expr    { $$ = BuildOrOp($1, $3) }
-    | expr TK_EQ expr    { $$ = BuildEqOp($1, $3) }
-    | expr TK_NEQ expr   { $$ = BuildNeqOp($1, $3) }
-    | expr TK_CMPOP expr { $$ = BuildCmpOp($1, $2, $3) }
+      TK_NOT expr        { $$ = BuildNotOp($2); setPos(Aladinolex, $$, $<pos>1) }
+    | '-' expr %prec UMINUS { $$ = BuildNegOp($2); setPos(Aladinolex, $$, $<pos>1) }
+    | expr TK_AND expr   { $$ = BuildAndOp($1, $3); setPos(Aladinolex, $$, $<pos>1) }
+    | expr TK_OR expr    { $$ = BuildOrOp($1, $3); setPos(Aladinolex, $$, $<pos>1) }
+    | expr TK_EQ expr    { $$ = BuildEqOp($1, $3); setPos(Aladinolex, $$, $<pos>1) }
+    | expr TK_NEQ expr   { $$ = BuildNeqOp($1, $3); setPos(Aladinolex, $$, $<pos>1) }
+    | expr TK_CMPOP expr { $$ = BuildCmpOp($1, $2, $3); setPos(Aladinolex, $$, $<pos>1) }
+    | expr TK_MATCH expr { $$ = BuildMatchOp($1, $3); setPos(Aladinolex, $$, $<pos>1) }
+    | expr TK_NMATCH expr { $$ = BuildNotMatchOp($1, $3); setPos(Aladinolex, $$, $<pos>1) }
+    | expr TK_IN expr    { $$ = BuildInOp($1, $3); setPos(Aladinolex, $$, $<pos>1) }
+    | TK_IF expr TK_THEN expr TK_ELSE expr { $$ = BuildConditional($2, $4, $6); setPos(Aladinolex, $$, $<pos>1) }
+    | expr '+' expr      { $$ = BuildAddOp($1, $3); setPos(Aladinolex, $$, $<pos>1) }
+    | expr '-' expr      { $$ = BuildSubOp($1, $3); setPos(Aladinolex, $$, $<pos>1) }
+    | expr '*' expr      { $$ = BuildMulOp($1, $3); setPos(Aladinolex, $$, $<pos>1) }
+    | expr '/' expr      { $$ = BuildDivOp($1, $3); setPos(Aladinolex, $$, $<pos>1) }
+    | expr '%' expr      { $$ = BuildModOp($1, $3); setPos(Aladinolex, $$, $<pos>1) }
     | '(' expr ')'       { $$ = $2 }
-    | TIMESTAMP          { $$ = BuildTimeConst($1) }
-    | RELATIVETIMESTAMP  { $$ = BuildRelativeTimeConst($1) }
-    | NUMBER             { $$ = BuildIntConst($1) }
-    | STRINGLITERAL      { $$ = BuildStringConst($1) }
-    | '[' expr_list ']'  { $$ = BuildArray($2) }
-    | '$' IDENTIFIER     { $$ = BuildVariable($2) }
-    | TRUE               { $$ = BuildBoolConst(true) }
-    | FALSE              { $$ = BuildBoolConst(false) }
-    | '$' IDENTIFIER '(' expr_list ')' 
-        { $$ = BuildFunctionCall(BuildVariable($2), $4) }
+    | '(' lambda_params TK_ARROW expr ')' { $$ = BuildLambda($2, $4); setPos(Aladinolex, $$, $<pos>1) }
+    | TIMESTAMP
+        {
+            timeConst, err := BuildTimeConst($1)
+            if err != nil {
+                setParseError(Aladinolex, $<pos>1, err.Error())
+                return 1
+            }
+            $$ = timeConst
+            setPos(Aladinolex, $$, $<pos>1)
+        }
+    | RELATIVETIMESTAMP
+        {
+            relativeTimeConst, err := BuildRelativeTimeConst($1)
+            if err != nil {
+                setParseError(Aladinolex, $<pos>1, err.Error())
+                return 1
+            }
+            $$ = relativeTimeConst
+            setPos(Aladinolex, $$, $<pos>1)
+        }
+    | NUMBER             { $$ = BuildIntConst($1); setPos(Aladinolex, $$, $<pos>1) }
+    | FLOAT              { $$ = BuildFloatConst($1); setPos(Aladinolex, $$, $<pos>1) }
+    | DURATION           { $$ = BuildDurationConst($1); setPos(Aladinolex, $$, $<pos>1) }
+    | STRINGLITERAL      { $$ = BuildStringConst($1); setPos(Aladinolex, $$, $<pos>1) }
+    | '[' expr_list ']'  { $$ = BuildArray($2); setPos(Aladinolex, $$, $<pos>1) }
+    | '$' IDENTIFIER     { $$ = BuildVariable($2); setPos(Aladinolex, $$, $<pos>1) }
+    | TRUE               { $$ = BuildBoolConst(true); setPos(Aladinolex, $$, $<pos>1) }
+    | FALSE              { $$ = BuildBoolConst(false); setPos(Aladinolex, $$, $<pos>1) }
+    | '$' IDENTIFIER '(' arg_list ')' 
+        {
+            name := BuildVariable($2)
+            setPos(Aladinolex, name, $<pos>1)
+            $$ = BuildFunctionCall(name, $4)
+            setPos(Aladinolex, $$, $<pos>1)
+        }
 ;
 
 expr_list :
@@ -70,4 +170,49 @@ expr_list :
     |                     { $$ = []Expr{} }
 ;
 
+arg_list :
+      arg ',' arg_list  { $$ = append([]Expr{$1}, $3...) }
+    | arg               { $$ = []Expr{$1} }
+    |                   { $$ = []Expr{} }
+;
+
+arg :
+      expr
+    | IDENTIFIER ':' expr { $$ = BuildNamedArg($1, $3); setPos(Aladinolex, $$, $<pos>1) }
+;
+
+lambda_params :
+      lambda_param ',' lambda_params { $$ = append([]Expr{$1}, $3...) }
+    | lambda_param                   { $$ = []Expr{$1} }
+;
+
+lambda_param :
+      '$' IDENTIFIER ':' type
+        {
+            param := BuildVariable($2)
+            setPos(Aladinolex, param, $<pos>1)
+            $$ = BuildTypedExpr(param, $4)
+            setPos(Aladinolex, $$, $<pos>1)
+        }
+;
+
+type :
+      IDENTIFIER
+        {
+            $$ = buildNamedType(Aladinolex, $1, $<pos>1)
+            if $$ == nil {
+                return 1
+            }
+        }
+    | '[' ']' type { $$ = BuildArrayOfType($3) }
+    | IDENTIFIER '[' type ']'
+        {
+            if $1 != "Map" {
+                setParseError(Aladinolex, $<pos>1, fmt.Sprintf("unknown type %v", $1))
+                return 1
+            }
+            $$ = BuildMapType($3)
+        }
+;
+
 %%      /*  start  of  programs  */
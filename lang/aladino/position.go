@@ -0,0 +1,63 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package aladino
+
+import "strings"
+
+// Position is the location of an expression in the spec it was parsed from.
+type Position struct {
+	// Offset in bytes from the start of the spec
+	Offset int
+	// Line and Column are 1-based
+	Line   int
+	Column int
+}
+
+// Positions are the positions of the expressions of a parsed spec.
+type Positions struct {
+	input   string
+	offsets map[Expr]int
+}
+
+// ParseWithPositions builds the AST of the input along with the position of each of its expressions.
+func ParseWithPositions(input string) (Expr, *Positions, error) {
+	expr, offsets, err := parse(input)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return expr, newPositions(input, offsets), nil
+}
+
+// ParseFunctionDefWithPositions builds the function definition of the input along with the position of each of its expressions.
+func ParseFunctionDefWithPositions(input string) (*FunctionDef, *Positions, error) {
+	def, offsets, err := parseFunctionDef(input)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return def, newPositions(input, offsets), nil
+}
+
+func newPositions(input string, offsets map[Expr]int) *Positions {
+	return &Positions{
+		// The parser ignores the trailing new lines
+		input:   strings.TrimRight(input, "\n"),
+		offsets: offsets,
+	}
+}
+
+// Of returns the position of an expression of the parsed spec.
+// It returns false when expr was not parsed from the spec.
+func (p *Positions) Of(expr Expr) (Position, bool) {
+	offset, ok := p.offsets[expr]
+	if !ok {
+		return Position{}, false
+	}
+
+	line, column := lineAndColumn(p.input, offset)
+
+	return Position{Offset: offset, Line: line + 1, Column: column}, true
+}
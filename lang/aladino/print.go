@@ -0,0 +1,225 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package aladino
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Precedence levels of the expressions, from the loosest to the tightest binding.
+// They follow the precedence declarations of the grammar (see parser.y).
+const (
+	conditionalPrec = iota
+	orPrec
+	andPrec
+	comparisonPrec
+	additivePrec
+	multiplicativePrec
+	unaryPrec
+	atomPrec
+)
+
+// Print returns the canonical source of expr. For instance,
+// BuildAndOp(BuildNotOp(BuildFunctionCall(BuildVariable("isDraft"), []Expr{})), BuildVariable("ready"))
+// is printed as !$isDraft() && $ready.
+// Parsing the source of an expression returns an expression equal to it.
+func Print(expr Expr) string {
+	var sb strings.Builder
+	printExpr(&sb, expr, conditionalPrec)
+	return sb.String()
+}
+
+// PrintFunctionDef returns the canonical source of a user-defined function
+// (e.g. touches(path: String): Bool = $hasFilePattern($path)).
+func PrintFunctionDef(def *FunctionDef) string {
+	params := make([]string, len(def.parameters))
+	for i, param := range def.parameters {
+		params[i] = fmt.Sprintf("%v: %v", def.paramName(i), formatType(param.(*TypedExpr).typeOf))
+	}
+
+	return fmt.Sprintf("%v(%v): %v = %v", def.name, strings.Join(params, ", "), formatType(def.returnType), Print(def.body))
+}
+
+// printExpr writes expr to sb, between parentheses when it binds looser than prec.
+func printExpr(sb *strings.Builder, expr Expr, prec int) {
+	exprPrec := precedence(expr)
+	if exprPrec < prec {
+		sb.WriteByte('(')
+		defer sb.WriteByte(')')
+	}
+
+	switch e := expr.(type) {
+	case *BoolConst:
+		sb.WriteString(strconv.FormatBool(e.value))
+	case *StringConst:
+		sb.WriteString(quote(e.value))
+	case *IntConst:
+		sb.WriteString(strconv.Itoa(e.value))
+	case *FloatConst:
+		sb.WriteString(formatFloat(e.value))
+	case *TimeConst:
+		sb.WriteString(formatTime(e.value))
+	case *DurationConst:
+		sb.WriteString(formatDuration(e.value))
+	case *RelativeTimeConst:
+		if e.amount == 1 {
+			sb.WriteString(fmt.Sprintf("%v %v ago", e.amount, e.unit))
+		} else {
+			sb.WriteString(fmt.Sprintf("%v %vs ago", e.amount, e.unit))
+		}
+	case *Variable:
+		sb.WriteString(fmt.Sprintf("$%v", e.ident))
+	case *UnaryOp:
+		sb.WriteString(e.op.getOperator())
+		printExpr(sb, e.expr, unaryPrec)
+	case *BinaryOp:
+		// Binary operators are left associative
+		printExpr(sb, e.lhs, exprPrec)
+		sb.WriteString(fmt.Sprintf(" %v ", e.op.getOperator()))
+		printExpr(sb, e.rhs, exprPrec+1)
+	case *Conditional:
+		sb.WriteString("if ")
+		printExpr(sb, e.condition, conditionalPrec)
+		sb.WriteString(" then ")
+		printExpr(sb, e.thenExpr, conditionalPrec)
+		sb.WriteString(" else ")
+		printExpr(sb, e.elseExpr, conditionalPrec)
+	case *FunctionCall:
+		sb.WriteString(fmt.Sprintf("$%v(", e.name.ident))
+		printList(sb, e.arguments)
+		sb.WriteByte(')')
+	case *NamedArg:
+		sb.WriteString(fmt.Sprintf("%v: ", e.name))
+		printExpr(sb, e.value, conditionalPrec)
+	case *Array:
+		sb.WriteByte('[')
+		printList(sb, e.elems)
+		sb.WriteByte(']')
+	case *TypedExpr:
+		printExpr(sb, e.expr, atomPrec)
+		sb.WriteString(fmt.Sprintf(": %v", formatType(e.typeOf)))
+	case *Lambda:
+		// Lambdas are always written between parentheses
+		sb.WriteByte('(')
+		printList(sb, e.parameters)
+		sb.WriteString(" => ")
+		printExpr(sb, e.body, conditionalPrec)
+		sb.WriteByte(')')
+	default:
+		sb.WriteString(expr.Kind())
+	}
+}
+
+func printList(sb *strings.Builder, exprs []Expr) {
+	for i, expr := range exprs {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		printExpr(sb, expr, conditionalPrec)
+	}
+}
+
+// precedence returns how tightly expr binds to its operands.
+func precedence(expr Expr) int {
+	switch e := expr.(type) {
+	case *Conditional:
+		// The else branch of a conditional extends as far as possible
+		return conditionalPrec
+	case *UnaryOp:
+		return unaryPrec
+	case *BinaryOp:
+		switch e.op.(type) {
+		case *OrOp:
+			return orPrec
+		case *AndOp:
+			return andPrec
+		case *AddOp, *SubOp:
+			return additivePrec
+		case *MulOp, *DivOp, *ModOp:
+			return multiplicativePrec
+		default:
+			return comparisonPrec
+		}
+	}
+
+	return atomPrec
+}
+
+// quote returns the string literal of str.
+// A backslash that does not start an escape sequence is kept as it is so that
+// regular expressions such as "new\(.*\)" are printed as they were written.
+func quote(str string) string {
+	var sb strings.Builder
+	sb.WriteByte('"')
+	for i := 0; i < len(str); i++ {
+		switch c := str[i]; c {
+		case '"':
+			sb.WriteString(`\"`)
+		case '\n':
+			sb.WriteString(`\n`)
+		case '\t':
+			sb.WriteString(`\t`)
+		case '\r':
+			sb.WriteString(`\r`)
+		case '\\':
+			if _, ok := escapeSequences[nextByte(str, i)]; ok || i == len(str)-1 {
+				sb.WriteString(`\\`)
+			} else {
+				sb.WriteByte('\\')
+			}
+		default:
+			sb.WriteByte(c)
+		}
+	}
+	sb.WriteByte('"')
+
+	return sb.String()
+}
+
+func nextByte(str string, i int) byte {
+	if i+1 < len(str) {
+		return str[i+1]
+	}
+
+	return 0
+}
+
+// formatFloat returns the float literal of f, which always has a fractional part or an exponent.
+func formatFloat(f float64) string {
+	str := strconv.FormatFloat(f, 'g', -1, 64)
+	if !strings.ContainsAny(str, ".e") {
+		str = fmt.Sprintf("%v.0", str)
+	}
+
+	return str
+}
+
+// formatTime returns the timestamp literal of a Unix time in seconds, leaving out the clock at midnight.
+func formatTime(unixTime int) string {
+	t := time.Unix(int64(unixTime), 0).UTC()
+	if t.Hour() == 0 && t.Minute() == 0 && t.Second() == 0 {
+		return t.Format("2006-01-02")
+	}
+
+	return t.Format("2006-01-02T15:04:05")
+}
+
+// Duration units from the largest to the smallest
+var durationUnitsOrder = []byte{'w', 'd', 'h', 'm', 's'}
+
+// formatDuration returns the duration literal of a number of seconds in the largest unit that divides it.
+func formatDuration(seconds int) string {
+	for _, unit := range durationUnitsOrder {
+		unitSeconds := durationUnits[unit]
+		if seconds != 0 && seconds%unitSeconds == 0 {
+			return fmt.Sprintf("%v%c", seconds/unitSeconds, unit)
+		}
+	}
+
+	return fmt.Sprintf("%vs", seconds)
+}
@@ -0,0 +1,140 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package aladino
+
+import (
+	"testing"
+
+	"github.com/reviewpad/reviewpad/v3/engine"
+	"github.com/stretchr/testify/assert"
+)
+
+// assertPrintRoundTrip checks that the input is printed as want and that want is parsed back into the same expression.
+func assertPrintRoundTrip(t *testing.T, input, want string) {
+	expr, err := Parse(input)
+	if err != nil {
+		assert.FailNow(t, "parse failed", "input %v: %v", input, err)
+	}
+
+	got := Print(expr)
+	assert.Equal(t, want, got)
+
+	reparsed, err := Parse(got)
+	if err != nil {
+		assert.FailNow(t, "parse of printed expression failed", "printed %v: %v", got, err)
+	}
+
+	assert.True(t, expr.equals(reparsed), "printed %v is not the same expression as %v", got, input)
+}
+
+func TestPrint_Constants(t *testing.T) {
+	assertPrintRoundTrip(t, "true", "true")
+	assertPrintRoundTrip(t, "42", "42")
+	assertPrintRoundTrip(t, "0.5", "0.5")
+	assertPrintRoundTrip(t, ".5", "0.5")
+	assertPrintRoundTrip(t, "2e3", "2000.0")
+	assertPrintRoundTrip(t, "'john'", `"john"`)
+	assertPrintRoundTrip(t, `"Lorem \"ipsum\"\n"`, `"Lorem \"ipsum\"\n"`)
+	assertPrintRoundTrip(t, `"new\(.*\)"`, `"new\(.*\)"`)
+	assertPrintRoundTrip(t, `"a\\n"`, `"a\\n"`)
+}
+
+func TestPrint_Times(t *testing.T) {
+	assertPrintRoundTrip(t, "20220405", "2022-04-05")
+	assertPrintRoundTrip(t, "20220405T22:01:50", "2022-04-05T22:01:50")
+	assertPrintRoundTrip(t, "1 days ago", "1 day ago")
+	assertPrintRoundTrip(t, "3 month ago", "3 months ago")
+}
+
+func TestPrint_Durations(t *testing.T) {
+	assertPrintRoundTrip(t, "14d", "2w")
+	assertPrintRoundTrip(t, "90m", "90m")
+	assertPrintRoundTrip(t, "3600s", "1h")
+	assertPrintRoundTrip(t, "0d", "0s")
+}
+
+func TestPrint_Operators(t *testing.T) {
+	assertPrintRoundTrip(t, "$size()<=30&&!$isDraft()", "$size() <= 30 && !$isDraft()")
+	assertPrintRoundTrip(t, "($a || $b) && $c", "($a || $b) && $c")
+	assertPrintRoundTrip(t, "($a && $b) || $c", "$a && $b || $c")
+	assertPrintRoundTrip(t, "1 - (2 - 3)", "1 - (2 - 3)")
+	assertPrintRoundTrip(t, "(1 - 2) - 3", "1 - 2 - 3")
+	assertPrintRoundTrip(t, "(1 + 2) * -3 % 2", "(1 + 2) * -3 % 2")
+	assertPrintRoundTrip(t, "!($a == $b)", "!($a == $b)")
+	assertPrintRoundTrip(t, "$author() in $group(\"seniors\")", `$author() in $group("seniors")`)
+	assertPrintRoundTrip(t, `$title() !~ "WIP"`, `$title() !~ "WIP"`)
+}
+
+func TestPrint_Conditional(t *testing.T) {
+	assertPrintRoundTrip(t, "if $a then 1 else 2", "if $a then 1 else 2")
+	assertPrintRoundTrip(t, "(if $a then 1 else 2) + 3", "(if $a then 1 else 2) + 3")
+	assertPrintRoundTrip(t, "3 + (if $a then 1 else 2)", "3 + (if $a then 1 else 2)")
+	assertPrintRoundTrip(t, "if $a then 1 else if $b then 2 else 3", "if $a then 1 else if $b then 2 else 3")
+}
+
+func TestPrint_FunctionCalls(t *testing.T) {
+	assertPrintRoundTrip(t, `$addLabel( "small","ship" )`, `$addLabel("small", "ship")`)
+	assertPrintRoundTrip(t, `$repeat("a", total:2)`, `$repeat("a", total: 2)`)
+	assertPrintRoundTrip(t, `[ "john" , "jane" ]`, `["john", "jane"]`)
+	assertPrintRoundTrip(t, "[]", "[]")
+}
+
+func TestPrint_Lambda(t *testing.T) {
+	assertPrintRoundTrip(
+		t,
+		`$filter($group("devs"), ($dev:String, $n: []Int => $totalCreatedPullRequests($dev)<10))`,
+		`$filter($group("devs"), ($dev: String, $n: []Int => $totalCreatedPullRequests($dev) < 10))`,
+	)
+}
+
+func TestPrintFunctionDef(t *testing.T) {
+	def, err := ParseFunctionDef("touches(path:String, paths: Map[[]String]):Bool=$hasFilePattern( $path )")
+	if err != nil {
+		assert.FailNow(t, "parse failed: %v", err)
+	}
+
+	got := PrintFunctionDef(def)
+
+	assert.Equal(t, "touches(path: String, paths: Map[[]String]): Bool = $hasFilePattern($path)", got)
+}
+
+func TestFormatter_FormatExpr(t *testing.T) {
+	formatted, err := NewFormatter().FormatExpr("$size()>100\n")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "$size() > 100", formatted)
+}
+
+func TestFormatter_FormatExpr_WhenParseFails(t *testing.T) {
+	_, err := NewFormatter().FormatExpr("$size() >")
+
+	assert.NotNil(t, err)
+}
+
+func TestFormatter_FormatExpr_WhenSpecHasComments(t *testing.T) {
+	_, err := NewFormatter().FormatExpr("# only small pull requests\n$size() <= 30")
+
+	assert.Equal(t, engine.ErrSpecHasComments, err)
+}
+
+func TestFormatter_FormatExpr_WhenStringHasHash(t *testing.T) {
+	formatted, err := NewFormatter().FormatExpr(`$addLabel( "#small")`)
+
+	assert.Nil(t, err)
+	assert.Equal(t, `$addLabel("#small")`, formatted)
+}
+
+func TestFormatter_FormatFunction_WhenSpecHasComments(t *testing.T) {
+	_, err := NewFormatter().FormatFunction("isBig(): Bool = $size() > 100 # lines")
+
+	assert.Equal(t, engine.ErrSpecHasComments, err)
+}
+
+func TestFormatter_FormatFunction(t *testing.T) {
+	formatted, err := NewFormatter().FormatFunction("isBig( ):Bool = $size()>100")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "isBig(): Bool = $size() > 100", formatted)
+}
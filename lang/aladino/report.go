@@ -7,6 +7,7 @@ package aladino
 import (
 	"fmt"
 	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/google/go-github/v42/github"
@@ -17,6 +18,15 @@ import (
 
 type Report struct {
 	WorkflowDetails map[string]ReportWorkflowDetails
+	// Explain records the traces of the evaluated conditions to explain the triggered rules.
+	// Tracing has a cost, so it is only enabled when the report is shown.
+	Explain bool
+	// Explanations are the traces of the rules that triggered the workflows by rule name
+	Explanations map[string]*Trace
+	// traces are the traces of the evaluated conditions by spec
+	traces map[string]*Trace
+	// Errors are the statements that failed when the errors are ignored
+	Errors []*engine.StatementError
 }
 
 type ReportWorkflowDetails struct {
@@ -43,6 +53,11 @@ func mergeReportWorkflowDetails(left, right ReportWorkflowDetails) ReportWorkflo
 }
 
 func (report *Report) addToReport(statement *engine.Statement) {
+	report.addWorkflow(statement, []string{statement.Code})
+}
+
+// addWorkflow adds the workflow and rules that triggered the statement along with the actions that ran.
+func (report *Report) addWorkflow(statement *engine.Statement, actions []string) {
 	workflowName := statement.Metadata.Workflow.Name
 
 	rules := make(map[string]bool, len(statement.Metadata.TriggeredBy))
@@ -54,7 +69,7 @@ func (report *Report) addToReport(statement *engine.Statement) {
 		Name:        workflowName,
 		Description: statement.Metadata.Workflow.Description,
 		Rules:       rules,
-		Actions:     []string{statement.Code},
+		Actions:     actions,
 	}
 
 	workflow, ok := report.WorkflowDetails[workflowName]
@@ -65,6 +80,45 @@ func (report *Report) addToReport(statement *engine.Statement) {
 	}
 }
 
+// addError adds the error of a statement that failed.
+// The workflow of the statement is activated even when none of its actions ran, so the error is always listed
+// along with the workflows of the report.
+func (report *Report) addError(err *engine.StatementError) {
+	report.addWorkflow(err.Statement, []string{})
+	report.Errors = append(report.Errors, err)
+}
+
+// addTrace records the trace of the evaluation of the condition spec.
+func (report *Report) addTrace(spec string, trace *Trace) {
+	if report.traces == nil {
+		report.traces = make(map[string]*Trace)
+	}
+
+	report.traces[spec] = trace
+}
+
+// explainRules adds the traces of the rules that triggered the statement to the explanations.
+// The specs of the rules are in the register map.
+func (report *Report) explainRules(statement *engine.Statement, registerMap RegisterMap) {
+	for _, rule := range statement.Metadata.TriggeredBy {
+		spec, ok := registerMap[BuildInternalRuleName(rule.Rule)]
+		if !ok {
+			continue
+		}
+
+		trace, ok := report.traces[spec.(*StringValue).Val]
+		if !ok {
+			continue
+		}
+
+		if report.Explanations == nil {
+			report.Explanations = make(map[string]*Trace)
+		}
+
+		report.Explanations[rule.Rule] = trace
+	}
+}
+
 func ReportHeader() string {
 	var sb strings.Builder
 
@@ -120,6 +174,64 @@ func BuildVerboseReport(report *Report) string {
 		sb.WriteString(fmt.Sprintf("| %v | %v | %v | %v |\n", workflow.Name, actRules, actActions, workflow.Description))
 	}
 
+	sb.WriteString(buildExplanations(report.Explanations))
+	sb.WriteString(buildErrors(report.Errors))
+
+	return sb.String()
+}
+
+// buildErrors renders the statements that failed along with the workflow and rules that triggered them.
+func buildErrors(errs []*engine.StatementError) string {
+	if len(errs) == 0 {
+		return ""
+	}
+
+	var sb strings.Builder
+
+	sb.WriteString("\n:warning: **Errors**\n")
+	sb.WriteString("| Workflow | Rules | Action | Error |\n")
+	sb.WriteString("| - | - | - | - |\n")
+
+	for _, err := range errs {
+		rules := ""
+		for _, rule := range err.Statement.RuleNames() {
+			rules += fmt.Sprintf("%v<br>", rule)
+		}
+
+		sb.WriteString(fmt.Sprintf("| %v | %v | `%v` | %v |\n", err.Statement.WorkflowName(), rules, err.Statement.Code, escapeTableCell(err.Err.Error())))
+	}
+
+	return sb.String()
+}
+
+// escapeTableCell keeps the text in a single cell of a markdown table.
+func escapeTableCell(text string) string {
+	text = strings.ReplaceAll(text, "|", "\\|")
+	return strings.ReplaceAll(text, "\n", "<br>")
+}
+
+// buildExplanations renders the traces of the triggered rules in a collapsible section.
+func buildExplanations(explanations map[string]*Trace) string {
+	if len(explanations) == 0 {
+		return ""
+	}
+
+	ruleNames := make([]string, 0, len(explanations))
+	for ruleName := range explanations {
+		ruleNames = append(ruleNames, ruleName)
+	}
+	sort.Strings(ruleNames)
+
+	var sb strings.Builder
+
+	sb.WriteString("\n<details>\n<summary>Why were the rules triggered?</summary>\n\n")
+
+	for _, ruleName := range ruleNames {
+		sb.WriteString(fmt.Sprintf("**%v**\n```\n%v\n```\n", ruleName, explanations[ruleName].Explain()))
+	}
+
+	sb.WriteString("</details>\n")
+
 	return sb.String()
 }
 
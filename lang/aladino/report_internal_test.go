@@ -234,6 +234,147 @@ func TestBuildVerboseReport(t *testing.T) {
 	assert.Equal(t, wantReport, gotReport)
 }
 
+func TestBuildVerboseReport_WhenRulesAreExplained(t *testing.T) {
+	report := Report{
+		WorkflowDetails: map[string]ReportWorkflowDetails{
+			"test-workflow": {
+				Name:        "test-workflow",
+				Description: "Testing workflow",
+				Rules:       map[string]bool{"is-large": true},
+				Actions:     []string{"$addLabel(\"large\")"},
+			},
+		},
+		Explanations: map[string]*Trace{
+			"is-large": {
+				Expr:  "> 100",
+				Value: BuildBoolValue(true),
+				Children: []*Trace{
+					{Expr: "$size()", Value: BuildIntValue(142)},
+				},
+			},
+		},
+	}
+
+	wantReport := `:scroll: **Explanation**
+| Workflows <sub><sup>activated</sup></sub> | Rules <sub><sup>triggered</sup></sub> | Actions <sub><sup>ran</sub></sup> | Description |
+| - | - | - | - |
+| test-workflow | is-large<br> | ` + "`$addLabel(\"large\")`" + `<br> | Testing workflow |
+
+<details>
+<summary>Why were the rules triggered?</summary>
+
+**is-large**
+` + "```" + `
+> 100 = true
+  $size() = 142
+` + "```" + `
+</details>
+`
+
+	gotReport := BuildVerboseReport(&report)
+
+	assert.Equal(t, wantReport, gotReport)
+}
+
+func TestBuildVerboseReport_WhenStatementsFailed(t *testing.T) {
+	report := Report{
+		WorkflowDetails: map[string]ReportWorkflowDetails{
+			"test-workflow": {
+				Name:        "test-workflow",
+				Description: "Testing workflow",
+				Rules:       map[string]bool{"tautology": true},
+				Actions:     []string{"$addLabel(\"test\")"},
+			},
+		},
+		Errors: []*engine.StatementError{
+			{
+				Statement: &engine.Statement{
+					Code: "$merge(\"rebase\")",
+					Metadata: &engine.Metadata{
+						Workflow:    engine.PadWorkflow{Name: "test-workflow"},
+						TriggeredBy: []engine.PadWorkflowRule{{Rule: "tautology"}},
+					},
+				},
+				Err: fmt.Errorf("merge failed |\nconflict"),
+			},
+		},
+	}
+
+	wantReport := `:scroll: **Explanation**
+| Workflows <sub><sup>activated</sup></sub> | Rules <sub><sup>triggered</sup></sub> | Actions <sub><sup>ran</sub></sup> | Description |
+| - | - | - | - |
+| test-workflow | tautology<br> | ` + "`$addLabel(\"test\")`" + `<br> | Testing workflow |
+
+:warning: **Errors**
+| Workflow | Rules | Action | Error |
+| - | - | - | - |
+| test-workflow | tautology<br> | ` + "`$merge(\"rebase\")`" + ` | merge failed \|<br>conflict |
+`
+
+	gotReport := BuildVerboseReport(&report)
+
+	assert.Equal(t, wantReport, gotReport)
+}
+
+func TestAddError_WhenWorkflowIsNonExisting(t *testing.T) {
+	statementErr := &engine.StatementError{
+		Statement: &engine.Statement{
+			Code: "$close()",
+			Metadata: &engine.Metadata{
+				Workflow:    engine.PadWorkflow{Name: "test-workflow", Description: "Testing workflow"},
+				TriggeredBy: []engine.PadWorkflowRule{{Rule: "tautology"}},
+			},
+		},
+		Err: fmt.Errorf("not found"),
+	}
+
+	report := Report{
+		WorkflowDetails: make(map[string]ReportWorkflowDetails),
+	}
+
+	wantReport := Report{
+		WorkflowDetails: map[string]ReportWorkflowDetails{
+			"test-workflow": {
+				Name:        "test-workflow",
+				Description: "Testing workflow",
+				Rules:       map[string]bool{"tautology": true},
+				Actions:     []string{},
+			},
+		},
+		Errors: []*engine.StatementError{statementErr},
+	}
+
+	report.addError(statementErr)
+
+	assert.Equal(t, wantReport, report)
+}
+
+func TestExplainRules(t *testing.T) {
+	trace := &Trace{Expr: "$isDraft()", Value: BuildBoolValue(true)}
+
+	report := &Report{}
+	report.addTrace("$isDraft()", trace)
+
+	registerMap := RegisterMap{
+		BuildInternalRuleName("is-draft"): BuildStringValue("$isDraft()"),
+	}
+
+	statement := &engine.Statement{
+		Code: "$addLabel(\"draft\")",
+		Metadata: &engine.Metadata{
+			Workflow: engine.PadWorkflow{Name: "test-workflow"},
+			TriggeredBy: []engine.PadWorkflowRule{
+				{Rule: "is-draft"},
+				{Rule: "unknown"},
+			},
+		},
+	}
+
+	report.explainRules(statement, registerMap)
+
+	assert.Equal(t, map[string]*Trace{"is-draft": trace}, report.Explanations)
+}
+
 func TestDeleteReportComment_WhenCommentCannotBeDeleted(t *testing.T) {
 	failMessage := "DeleteCommentRequestFailed"
 	mockedEnv, err := MockDefaultEnv(
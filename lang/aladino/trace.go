@@ -0,0 +1,188 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package aladino
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Trace is the value of an expression during the evaluation of a condition
+// along with the traces of its sub-expressions. For instance, the trace of
+// $size() > 100 && !$isDraft() is explained as:
+//
+//	&& = true
+//	  > 100 = true
+//	    $size() = 142
+//	  ! = true
+//	    $isDraft() = false
+//
+// Constants are not traced on their own but are shown next to their operator.
+type Trace struct {
+	Expr string
+	// Value is nil when the evaluation of the expression failed
+	Value    Value
+	Children []*Trace
+}
+
+// tracingEnv is an environment that records the trace of the evaluated expressions.
+type tracingEnv struct {
+	Env
+	// current is the trace of the expression being evaluated
+	current *Trace
+}
+
+// findTracer returns the tracing environment that e is nested in (nil when there is none).
+func findTracer(e Env) *tracingEnv {
+	for {
+		switch env := e.(type) {
+		case *tracingEnv:
+			return env
+		case *scopedEnv:
+			e = env.Env
+		default:
+			return nil
+		}
+	}
+}
+
+// evalExpr evaluates a sub-expression, recording its trace when the evaluation is traced.
+func evalExpr(e Env, expr Expr) (Value, error) {
+	tracer := findTracer(e)
+	if tracer == nil || !isTraced(expr) {
+		return expr.Eval(e)
+	}
+
+	trace := &Trace{Expr: describeExpr(expr)}
+	parent := tracer.current
+	parent.Children = append(parent.Children, trace)
+
+	tracer.current = trace
+	value, err := expr.Eval(e)
+	tracer.current = parent
+
+	trace.Value = value
+
+	return value, err
+}
+
+// EvalConditionWithTrace evaluates a boolean expression and records the value of each of its sub-expressions.
+// Pre-condition: the type of expr is BoolType
+func EvalConditionWithTrace(env Env, expr Expr) (bool, *Trace, error) {
+	trace := &Trace{Expr: describeExpr(expr)}
+
+	boolVal, err := expr.Eval(&tracingEnv{Env: env, current: trace})
+	if err != nil {
+		return false, trace, err
+	}
+
+	trace.Value = boolVal
+
+	return boolVal.(*BoolValue).Val, trace, nil
+}
+
+// Explain renders the trace as an indented tree with one expression per line.
+func (trace *Trace) Explain() string {
+	var sb strings.Builder
+	trace.explain(&sb, 0)
+	return strings.TrimRight(sb.String(), "\n")
+}
+
+func (trace *Trace) explain(sb *strings.Builder, depth int) {
+	sb.WriteString(fmt.Sprintf("%v%v = %v\n", strings.Repeat("  ", depth), trace.Expr, formatValue(trace.Value)))
+
+	for _, child := range trace.Children {
+		child.explain(sb, depth+1)
+	}
+}
+
+// isTraced checks if the value of expr is worth recording.
+// Constants are shown in the description of their operator instead.
+func isTraced(expr Expr) bool {
+	switch expr.(type) {
+	case *BoolConst, *StringConst, *IntConst, *FloatConst, *TimeConst, *DurationConst:
+		return false
+	case *Lambda, *NamedArg, *TypedExpr:
+		return false
+	}
+
+	return true
+}
+
+// describeExpr returns a short description of expr, where sub-expressions are left out.
+// Constants and variables are written as in the spec.
+func describeExpr(expr Expr) string {
+	switch e := expr.(type) {
+	case *BoolConst, *StringConst, *IntConst, *FloatConst, *TimeConst, *DurationConst, *RelativeTimeConst, *Variable:
+		return Print(e)
+	case *FunctionCall:
+		if len(e.arguments) == 0 {
+			return fmt.Sprintf("$%v()", e.name.ident)
+		}
+		return fmt.Sprintf("$%v(...)", e.name.ident)
+	case *UnaryOp:
+		return e.op.getOperator()
+	case *BinaryOp:
+		description := e.op.getOperator()
+		if !isTraced(e.lhs) {
+			description = fmt.Sprintf("%v %v", describeExpr(e.lhs), description)
+		}
+		if !isTraced(e.rhs) {
+			description = fmt.Sprintf("%v %v", description, describeExpr(e.rhs))
+		}
+		return description
+	case *Conditional:
+		return "if"
+	case *Array:
+		return "[...]"
+	}
+
+	return expr.Kind()
+}
+
+// formatValue renders a value in the surface syntax.
+func formatValue(value Value) string {
+	switch val := value.(type) {
+	case nil:
+		return "<error>"
+	case *IntValue:
+		return strconv.Itoa(val.Val)
+	case *FloatValue:
+		return strconv.FormatFloat(val.Val, 'g', -1, 64)
+	case *BoolValue:
+		return strconv.FormatBool(val.Val)
+	case *StringValue:
+		return strconv.Quote(val.Val)
+	case *TimeValue:
+		return time.Unix(int64(val.Val), 0).UTC().Format(time.RFC3339)
+	case *DurationValue:
+		return (time.Duration(val.Val) * time.Second).String()
+	case *ArrayValue:
+		elems := make([]string, len(val.Vals))
+		for i, elem := range val.Vals {
+			elems[i] = formatValue(elem)
+		}
+		return fmt.Sprintf("[%v]", strings.Join(elems, ", "))
+	case *MapValue:
+		keys := make([]string, 0, len(val.Vals))
+		for key := range val.Vals {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		entries := make([]string, len(keys))
+		for i, key := range keys {
+			entries[i] = fmt.Sprintf("%v: %v", strconv.Quote(key), formatValue(val.Vals[key]))
+		}
+		return fmt.Sprintf("{%v}", strings.Join(entries, ", "))
+	case *FunctionValue:
+		return "<function>"
+	}
+
+	return value.Kind()
+}
@@ -0,0 +1,143 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package aladino
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEvalConditionWithTrace(t *testing.T) {
+	mockedEnv, err := MockDefaultEnv(nil, nil)
+	if err != nil {
+		assert.FailNow(t, "MockDefaultEnv failed: %v", err)
+	}
+
+	expr, err := Parse(`$zeroConst() < 1 && !($returnStr("a") == "b")`)
+	if err != nil {
+		assert.FailNow(t, "parse failed: %v", err)
+	}
+
+	gotVal, gotTrace, err := EvalConditionWithTrace(mockedEnv, expr)
+
+	wantTrace := &Trace{
+		Expr:  "&&",
+		Value: BuildBoolValue(true),
+		Children: []*Trace{
+			{
+				Expr:  "< 1",
+				Value: BuildBoolValue(true),
+				Children: []*Trace{
+					{Expr: "$zeroConst()", Value: BuildIntValue(0)},
+				},
+			},
+			{
+				Expr:  "!",
+				Value: BuildBoolValue(true),
+				Children: []*Trace{
+					{
+						Expr:  "== \"b\"",
+						Value: BuildBoolValue(false),
+						Children: []*Trace{
+							{Expr: "$returnStr(...)", Value: BuildStringValue("a")},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	assert.Nil(t, err)
+	assert.True(t, gotVal)
+	assert.Equal(t, wantTrace, gotTrace)
+}
+
+func TestEvalConditionWithTrace_WhenConditionalOnlyTracesChosenBranch(t *testing.T) {
+	mockedEnv, err := MockDefaultEnv(nil, nil)
+	if err != nil {
+		assert.FailNow(t, "MockDefaultEnv failed: %v", err)
+	}
+
+	expr, err := Parse(`if $zeroConst() == 0 then $returnStr("a") == "a" else $returnStr("b") == "a"`)
+	if err != nil {
+		assert.FailNow(t, "parse failed: %v", err)
+	}
+
+	_, gotTrace, err := EvalConditionWithTrace(mockedEnv, expr)
+
+	wantExplanation := "if = true\n" +
+		"  == 0 = true\n" +
+		"    $zeroConst() = 0\n" +
+		"  == \"a\" = true\n" +
+		"    $returnStr(...) = \"a\""
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantExplanation, gotTrace.Explain())
+}
+
+func TestEvalConditionWithTrace_WhenEvalFails(t *testing.T) {
+	mockedEnv, err := MockDefaultEnv(nil, nil)
+	if err != nil {
+		assert.FailNow(t, "MockDefaultEnv failed: %v", err)
+	}
+
+	expr := BuildEqOp(BuildFunctionCall(BuildVariable("unknown"), []Expr{}), BuildIntConst(0))
+
+	gotVal, gotTrace, err := EvalConditionWithTrace(mockedEnv, expr)
+
+	assert.EqualError(t, err, "eval: failure on unknown")
+	assert.False(t, gotVal)
+	assert.Equal(t, "== 0 = <error>\n  $unknown() = <error>", gotTrace.Explain())
+}
+
+func TestEval_WhenNotTraced(t *testing.T) {
+	mockedEnv, err := MockDefaultEnv(nil, nil)
+	if err != nil {
+		assert.FailNow(t, "MockDefaultEnv failed: %v", err)
+	}
+
+	gotVal, err := evalExpr(mockedEnv, BuildNotOp(BuildBoolConst(false)))
+
+	assert.Nil(t, err)
+	assert.Equal(t, BuildBoolValue(true), gotVal)
+}
+
+func TestTraceExplain(t *testing.T) {
+	trace := &Trace{
+		Expr:  "> 100",
+		Value: BuildBoolValue(true),
+		Children: []*Trace{
+			{Expr: "$size()", Value: BuildIntValue(142)},
+		},
+	}
+
+	assert.Equal(t, "> 100 = true\n  $size() = 142", trace.Explain())
+}
+
+func TestDescribeExpr(t *testing.T) {
+	assert.Equal(t, "$size()", describeExpr(BuildFunctionCall(BuildVariable("size"), []Expr{})))
+	assert.Equal(t, "$isElementOf(...)", describeExpr(BuildFunctionCall(BuildVariable("isElementOf"), []Expr{BuildStringConst("a")})))
+	assert.Equal(t, "$dev", describeExpr(BuildVariable("dev")))
+	assert.Equal(t, "> 100", describeExpr(BuildGreaterThanOp(BuildVariable("x"), BuildIntConst(100))))
+	assert.Equal(t, "1.5 <", describeExpr(BuildLessThanOp(BuildFloatConst(1.5), BuildVariable("x"))))
+	assert.Equal(t, "&&", describeExpr(BuildAndOp(BuildVariable("x"), BuildVariable("y"))))
+	assert.Equal(t, "!", describeExpr(BuildNotOp(BuildVariable("x"))))
+	assert.Equal(t, "3 days ago", describeExpr(&RelativeTimeConst{3, "day"}))
+	assert.Equal(t, "[...]", describeExpr(BuildArray([]Expr{})))
+}
+
+func TestFormatValue(t *testing.T) {
+	assert.Equal(t, "<error>", formatValue(nil))
+	assert.Equal(t, "142", formatValue(BuildIntValue(142)))
+	assert.Equal(t, "0.5", formatValue(BuildFloatValue(0.5)))
+	assert.Equal(t, "false", formatValue(BuildBoolValue(false)))
+	assert.Equal(t, "\"john\"", formatValue(BuildStringValue("john")))
+	assert.Equal(t, "2022-04-05T00:00:00Z", formatValue(BuildTimeValue(1649116800)))
+	assert.Equal(t, "72h0m0s", formatValue(BuildDurationValue(3*24*60*60)))
+	assert.Equal(t, "[\"a\", \"b\"]", formatValue(BuildArrayValue([]Value{BuildStringValue("a"), BuildStringValue("b")})))
+	assert.Equal(t, "{\"a\": 1, \"b\": 2}", formatValue(BuildMapValue(map[string]Value{"b": BuildIntValue(2), "a": BuildIntValue(1)})))
+	assert.Equal(t, "<function>", formatValue(BuildFunctionValue(nil)))
+}
@@ -12,21 +12,49 @@ type Type interface {
 const (
 	BOOL_TYPE     string = "BoolType"
 	INT_TYPE      string = "IntType"
+	FLOAT_TYPE    string = "FloatType"
 	STRING_TYPE   string = "StringType"
+	TIME_TYPE     string = "TimeType"
+	DURATION_TYPE string = "DurationType"
 	FUNCTION_TYPE string = "FunctionType"
 	ARRAY_TYPE    string = "ArrayType"
 	ARRAY_OF_TYPE string = "ArrayOfType"
+	MAP_TYPE      string = "MapType"
+	JSON_TYPE     string = "JSONType"
+	TYPE_VAR      string = "TypeVar"
 )
 
 type StringType struct{}
 
 type IntType struct{}
 
+type FloatType struct{}
+
 type BoolType struct{}
 
+// TimeType is the type of instants in time (e.g. 2022-04-05 or $createdAt())
+type TimeType struct{}
+
+// DurationType is the type of the elapsed time between two instants (e.g. 3d or 12h)
+type DurationType struct{}
+
 type FunctionType struct {
 	paramTypes []Type
 	returnType Type
+	// paramNames are the names of the parameters for calls with named arguments (e.g. total: 2).
+	// It is empty when the parameters have no names.
+	paramNames []string
+	// defaults are the values of the optional parameters, which are the last ones.
+	defaults []Value
+	// variadic is true when the last parameter takes one or more arguments (e.g. $concat("a", "b", "c")).
+	variadic bool
+}
+
+// Param is a parameter of a built-in, which is optional when it has a default value.
+type Param struct {
+	name         string
+	typeOf       Type
+	defaultValue Value
 }
 
 type ArrayOfType struct {
@@ -38,12 +66,123 @@ type ArrayType struct {
 	elemsType []Type
 }
 
-func BuildStringType() *StringType { return &StringType{} }
-func BuildIntType() *IntType       { return &IntType{} }
-func BuildBoolType() *BoolType     { return &BoolType{} }
+// TypeVar is a type variable in the signature of a polymorphic built-in.
+// For instance, the type of $length is ([]a) => Int where a is a type variable,
+// i.e. $length works on arrays of any type.
+type TypeVar struct {
+	name string
+}
+
+// MapType is the type of maps from strings to values of valueType (e.g. Map[String])
+type MapType struct {
+	valueType Type
+}
+
+// JSONType is the type of the values of the event payload (e.g. $event("pull_request.number")),
+// which are only known when the event is received.
+// A JSON value can be used where a value of any type is expected and it is checked when the expression is evaluated.
+type JSONType struct{}
+
+func BuildStringType() *StringType     { return &StringType{} }
+func BuildIntType() *IntType           { return &IntType{} }
+func BuildFloatType() *FloatType       { return &FloatType{} }
+func BuildBoolType() *BoolType         { return &BoolType{} }
+func BuildTimeType() *TimeType         { return &TimeType{} }
+func BuildDurationType() *DurationType { return &DurationType{} }
+
+// BuildTypeFromName returns the type with the given name in the surface syntax (nil when unknown).
+func BuildTypeFromName(name string) Type {
+	switch name {
+	case "Bool":
+		return BuildBoolType()
+	case "Int":
+		return BuildIntType()
+	case "Float":
+		return BuildFloatType()
+	case "String":
+		return BuildStringType()
+	case "Time":
+		return BuildTimeType()
+	case "Duration":
+		return BuildDurationType()
+	case "JSON":
+		return BuildJSONType()
+	}
+	return nil
+}
 
 func BuildFunctionType(paramsTypes []Type, returnType Type) *FunctionType {
-	return &FunctionType{paramsTypes, returnType}
+	return &FunctionType{paramsTypes, returnType, nil, nil, false}
+}
+
+// BuildVariadicFunctionType builds the type of a built-in where the last parameter
+// takes one or more arguments of its type.
+func BuildVariadicFunctionType(paramsTypes []Type, returnType Type) *FunctionType {
+	return &FunctionType{paramsTypes, returnType, nil, nil, true}
+}
+
+func BuildParam(name string, typeOf Type) *Param {
+	return &Param{name, typeOf, nil}
+}
+
+func BuildOptionalParam(name string, typeOf Type, defaultValue Value) *Param {
+	return &Param{name, typeOf, defaultValue}
+}
+
+// BuildFunctionTypeWithParams builds the type of a built-in with named parameters.
+// The optional parameters must come after the other parameters.
+func BuildFunctionTypeWithParams(params []*Param, returnType Type) *FunctionType {
+	paramTypes := make([]Type, len(params))
+	paramNames := make([]string, len(params))
+	defaults := make([]Value, 0)
+	for i, param := range params {
+		paramTypes[i] = param.typeOf
+		paramNames[i] = param.name
+		if param.defaultValue != nil {
+			defaults = append(defaults, param.defaultValue)
+		}
+	}
+
+	return &FunctionType{paramTypes, returnType, paramNames, defaults, false}
+}
+
+// paramIndex returns the position of the parameter with the given name (-1 when there is none).
+func (fTy *FunctionType) paramIndex(name string) int {
+	for i, paramName := range fTy.paramNames {
+		if paramName == name {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// defaultValue returns the default value of the i-th parameter (nil when it is not optional).
+func (fTy *FunctionType) defaultValue(i int) Value {
+	firstOptional := len(fTy.paramTypes) - len(fTy.defaults)
+	if i < firstOptional {
+		return nil
+	}
+
+	return fTy.defaults[i-firstOptional]
+}
+
+// expand returns the type of a call with numArgs arguments to a variadic built-in,
+// where the last parameter is repeated once for each extra argument.
+// The last parameter is kept when there are no arguments for it, so that it is reported as missing.
+func (fTy *FunctionType) expand(numArgs int) *FunctionType {
+	if !fTy.variadic || numArgs <= len(fTy.paramTypes) {
+		return &FunctionType{fTy.paramTypes, fTy.returnType, fTy.paramNames, fTy.defaults, false}
+	}
+
+	variadicType := fTy.paramTypes[len(fTy.paramTypes)-1]
+	paramTypes := make([]Type, numArgs)
+	copy(paramTypes, fTy.paramTypes)
+	for i := len(fTy.paramTypes); i < numArgs; i++ {
+		paramTypes[i] = variadicType
+	}
+
+	return &FunctionType{paramTypes, fTy.returnType, fTy.paramNames, fTy.defaults, false}
 }
 
 func BuildArrayOfType(elemType Type) *ArrayOfType {
@@ -54,6 +193,18 @@ func BuildArrayType(elemsTypes []Type) *ArrayType {
 	return &ArrayType{elemsTypes}
 }
 
+func BuildMapType(valueType Type) *MapType {
+	return &MapType{valueType}
+}
+
+func BuildJSONType() *JSONType {
+	return &JSONType{}
+}
+
+func BuildTypeVar(name string) *TypeVar {
+	return &TypeVar{name}
+}
+
 func (bTy *BoolType) Kind() string {
 	return BOOL_TYPE
 }
@@ -62,10 +213,22 @@ func (iTy *IntType) Kind() string {
 	return INT_TYPE
 }
 
+func (fTy *FloatType) Kind() string {
+	return FLOAT_TYPE
+}
+
 func (sTy *StringType) Kind() string {
 	return STRING_TYPE
 }
 
+func (tTy *TimeType) Kind() string {
+	return TIME_TYPE
+}
+
+func (dTy *DurationType) Kind() string {
+	return DURATION_TYPE
+}
+
 func (fTy *FunctionType) Kind() string {
 	return FUNCTION_TYPE
 }
@@ -78,6 +241,18 @@ func (aTy *ArrayOfType) Kind() string {
 	return ARRAY_OF_TYPE
 }
 
+func (mTy *MapType) Kind() string {
+	return MAP_TYPE
+}
+
+func (jTy *JSONType) Kind() string {
+	return JSON_TYPE
+}
+
+func (vTy *TypeVar) Kind() string {
+	return TYPE_VAR
+}
+
 // Equals
 // equals on arrays
 func equals(leftTys []Type, rightTys []Type) bool {
@@ -108,6 +283,18 @@ func (thisTy *IntType) equals(thatTy Type) bool {
 	return thatTy.Kind() == thisTy.Kind()
 }
 
+func (thisTy *FloatType) equals(thatTy Type) bool {
+	return thatTy.Kind() == thisTy.Kind()
+}
+
+func (thisTy *TimeType) equals(thatTy Type) bool {
+	return thatTy.Kind() == thisTy.Kind()
+}
+
+func (thisTy *DurationType) equals(thatTy Type) bool {
+	return thatTy.Kind() == thisTy.Kind()
+}
+
 func (thisTy *FunctionType) equals(thatTy Type) bool {
 	if thisTy.Kind() != thatTy.Kind() {
 		return false
@@ -117,7 +304,7 @@ func (thisTy *FunctionType) equals(thatTy Type) bool {
 	argsCheck := equals(thisTy.paramTypes, thatTyFunction.paramTypes)
 	retCheck := thisTy.returnType.equals(thatTyFunction.returnType)
 
-	return argsCheck && retCheck
+	return argsCheck && retCheck && thisTy.variadic == thatTyFunction.variadic
 }
 
 func (thisTy *ArrayType) equals(thatTy Type) bool {
@@ -146,3 +333,23 @@ func (thisTy *ArrayOfType) equals(thatTy Type) bool {
 	}
 	return false
 }
+
+func (thisTy *MapType) equals(thatTy Type) bool {
+	if thatTy.Kind() != thisTy.Kind() {
+		return false
+	}
+
+	return thisTy.valueType.equals(thatTy.(*MapType).valueType)
+}
+
+func (thisTy *JSONType) equals(thatTy Type) bool {
+	return thatTy.Kind() == thisTy.Kind()
+}
+
+func (thisTy *TypeVar) equals(thatTy Type) bool {
+	if thatTy.Kind() != thisTy.Kind() {
+		return false
+	}
+
+	return thisTy.name == thatTy.(*TypeVar).name
+}
@@ -24,6 +24,13 @@ func TestBuildIntType(t *testing.T) {
 	assert.Equal(t, wantVal, gotVal)
 }
 
+func TestBuildFloatType(t *testing.T) {
+	wantVal := &FloatType{}
+	gotVal := BuildFloatType()
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
 func TestBuildBoolType(t *testing.T) {
 	wantVal := &BoolType{}
 	gotVal := BuildBoolType()
@@ -31,13 +38,39 @@ func TestBuildBoolType(t *testing.T) {
 	assert.Equal(t, wantVal, gotVal)
 }
 
+func TestBuildTimeType(t *testing.T) {
+	wantVal := &TimeType{}
+	gotVal := BuildTimeType()
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestBuildDurationType(t *testing.T) {
+	wantVal := &DurationType{}
+	gotVal := BuildDurationType()
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
 func TestBuildFunctionType(t *testing.T) {
-	wantVal := &FunctionType{[]Type{&StringType{}}, &StringType{}}
+	wantVal := &FunctionType{[]Type{&StringType{}}, &StringType{}, nil, nil, false}
 	gotVal := BuildFunctionType([]Type{&StringType{}}, &StringType{})
 
 	assert.Equal(t, wantVal, gotVal)
 }
 
+func TestBuildTypeFromName(t *testing.T) {
+	assert.Equal(t, BuildBoolType(), BuildTypeFromName("Bool"))
+	assert.Equal(t, BuildIntType(), BuildTypeFromName("Int"))
+	assert.Equal(t, BuildStringType(), BuildTypeFromName("String"))
+	assert.Equal(t, BuildTimeType(), BuildTypeFromName("Time"))
+	assert.Equal(t, BuildDurationType(), BuildTypeFromName("Duration"))
+}
+
+func TestBuildTypeFromName_WhenUnknown(t *testing.T) {
+	assert.Nil(t, BuildTypeFromName("Foo"))
+}
+
 func TestBuildArrayOfType(t *testing.T) {
 	wantVal := &ArrayOfType{&StringType{}}
 	gotVal := BuildArrayOfType(&StringType{})
@@ -66,6 +99,13 @@ func TestKind_WhenIntType(t *testing.T) {
 	assert.Equal(t, wantVal, gotVal)
 }
 
+func TestKind_WhenFloatType(t *testing.T) {
+	wantVal := FLOAT_TYPE
+	gotVal := BuildFloatType().Kind()
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
 func TestKind_WhenStringType(t *testing.T) {
 	wantVal := STRING_TYPE
 	gotVal := BuildStringType().Kind()
@@ -73,6 +113,20 @@ func TestKind_WhenStringType(t *testing.T) {
 	assert.Equal(t, wantVal, gotVal)
 }
 
+func TestKind_WhenTimeType(t *testing.T) {
+	wantVal := TIME_TYPE
+	gotVal := BuildTimeType().Kind()
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestKind_WhenDurationType(t *testing.T) {
+	wantVal := DURATION_TYPE
+	gotVal := BuildDurationType().Kind()
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
 func TestKind_WhenFunctionType(t *testing.T) {
 	wantVal := FUNCTION_TYPE
 	gotVal := BuildFunctionType([]Type{&StringType{}}, &StringType{}).Kind()
@@ -157,6 +211,146 @@ func TestEquals_WhenIntTypeComparedToSameType(t *testing.T) {
 	assert.True(t, intType.equals(otherType))
 }
 
+func TestEquals_WhenFloatTypeComparedToIntType(t *testing.T) {
+	floatType := BuildFloatType()
+	otherType := BuildIntType()
+
+	assert.False(t, floatType.equals(otherType))
+}
+
+func TestBuildMapType(t *testing.T) {
+	wantVal := &MapType{&StringType{}}
+	gotVal := BuildMapType(BuildStringType())
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestKind_WhenMapType(t *testing.T) {
+	wantVal := MAP_TYPE
+	gotVal := BuildMapType(BuildStringType()).Kind()
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestEquals_WhenMapTypeComparedToArrayOfType(t *testing.T) {
+	mapType := BuildMapType(BuildStringType())
+	otherType := BuildArrayOfType(BuildStringType())
+
+	assert.False(t, mapType.equals(otherType))
+}
+
+func TestEquals_WhenMapTypeComparedToMapTypeOfDiffValueType(t *testing.T) {
+	mapType := BuildMapType(BuildStringType())
+	otherType := BuildMapType(BuildIntType())
+
+	assert.False(t, mapType.equals(otherType))
+}
+
+func TestEquals_WhenMapTypeComparedToSameType(t *testing.T) {
+	mapType := BuildMapType(BuildStringType())
+	otherType := BuildMapType(BuildStringType())
+
+	assert.True(t, mapType.equals(otherType))
+}
+
+func TestBuildFunctionTypeWithParams(t *testing.T) {
+	wantVal := &FunctionType{
+		[]Type{&StringType{}, &IntType{}},
+		nil,
+		[]string{"reviewers", "total"},
+		[]Value{BuildIntValue(99)},
+		false,
+	}
+	gotVal := BuildFunctionTypeWithParams(
+		[]*Param{
+			BuildParam("reviewers", BuildStringType()),
+			BuildOptionalParam("total", BuildIntType(), BuildIntValue(99)),
+		},
+		nil,
+	)
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestParamIndex(t *testing.T) {
+	fnType := BuildFunctionTypeWithParams(
+		[]*Param{BuildParam("reviewers", BuildStringType()), BuildParam("total", BuildIntType())},
+		nil,
+	)
+
+	assert.Equal(t, 1, fnType.paramIndex("total"))
+	assert.Equal(t, -1, fnType.paramIndex("count"))
+}
+
+func TestDefaultValue(t *testing.T) {
+	fnType := BuildFunctionTypeWithParams(
+		[]*Param{
+			BuildParam("reviewers", BuildStringType()),
+			BuildOptionalParam("total", BuildIntType(), BuildIntValue(99)),
+		},
+		nil,
+	)
+
+	assert.Nil(t, fnType.defaultValue(0))
+	assert.Equal(t, BuildIntValue(99), fnType.defaultValue(1))
+}
+
+func TestBuildTypeVar(t *testing.T) {
+	wantVal := &TypeVar{"a"}
+	gotVal := BuildTypeVar("a")
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestKind_WhenTypeVar(t *testing.T) {
+	wantVal := TYPE_VAR
+	gotVal := BuildTypeVar("a").Kind()
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestEquals_WhenTypeVarComparedToDiffTypeVar(t *testing.T) {
+	typeVar := BuildTypeVar("a")
+	otherType := BuildTypeVar("b")
+
+	assert.False(t, typeVar.equals(otherType))
+}
+
+func TestEquals_WhenTypeVarComparedToSameTypeVar(t *testing.T) {
+	typeVar := BuildTypeVar("a")
+	otherType := BuildTypeVar("a")
+
+	assert.True(t, typeVar.equals(otherType))
+}
+
+func TestEquals_WhenTimeTypeComparedToDurationType(t *testing.T) {
+	timeType := BuildTimeType()
+	otherType := BuildDurationType()
+
+	assert.False(t, timeType.equals(otherType))
+}
+
+func TestEquals_WhenTimeTypeComparedToSameType(t *testing.T) {
+	timeType := BuildTimeType()
+	otherType := BuildTimeType()
+
+	assert.True(t, timeType.equals(otherType))
+}
+
+func TestEquals_WhenDurationTypeComparedToIntType(t *testing.T) {
+	durationType := BuildDurationType()
+	otherType := BuildIntType()
+
+	assert.False(t, durationType.equals(otherType))
+}
+
+func TestEquals_WhenDurationTypeComparedToSameType(t *testing.T) {
+	durationType := BuildDurationType()
+	otherType := BuildDurationType()
+
+	assert.True(t, durationType.equals(otherType))
+}
+
 func TestEquals_WhenFunctionTypeComparedToIntType(t *testing.T) {
 	functionType := BuildFunctionType([]Type{BuildStringType()}, BuildIntType())
 	otherType := BuildIntType()
@@ -240,3 +434,35 @@ func TestEquals_WhenArrayOfTypeComparedToStringType(t *testing.T) {
 
 	assert.False(t, arrayOfType.equals(otherType))
 }
+
+func TestBuildVariadicFunctionType(t *testing.T) {
+	wantVal := &FunctionType{[]Type{&StringType{}}, &StringType{}, nil, nil, true}
+	gotVal := BuildVariadicFunctionType([]Type{BuildStringType()}, BuildStringType())
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestFunctionTypeEquals_WhenDiffVariadic(t *testing.T) {
+	fnType := BuildFunctionType([]Type{BuildStringType()}, BuildStringType())
+	variadicFnType := BuildVariadicFunctionType([]Type{BuildStringType()}, BuildStringType())
+
+	assert.False(t, fnType.equals(variadicFnType))
+}
+
+func TestExpand(t *testing.T) {
+	fnType := BuildVariadicFunctionType([]Type{BuildIntType(), BuildStringType()}, nil)
+
+	wantVal := BuildFunctionType([]Type{BuildIntType(), BuildStringType(), BuildStringType(), BuildStringType()}, nil)
+	gotVal := fnType.expand(4)
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestExpand_WhenVariadicParameterHasNoArguments(t *testing.T) {
+	fnType := BuildVariadicFunctionType([]Type{BuildIntType(), BuildStringType()}, nil)
+
+	wantVal := BuildFunctionType([]Type{BuildIntType(), BuildStringType()}, nil)
+	gotVal := fnType.expand(1)
+
+	assert.Equal(t, wantVal, gotVal)
+}
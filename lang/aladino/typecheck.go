@@ -0,0 +1,161 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package aladino
+
+import (
+	"fmt"
+
+	"github.com/reviewpad/reviewpad/v3/engine"
+)
+
+// TypeChecker type checks Aladino specs and actions against the built-ins signatures.
+// Unlike the Interpreter, it does not require a pull request nor a GitHub client.
+// Errors are reported as a *Diagnostic.
+type TypeChecker struct {
+	BuiltIns *BuiltIns
+	// compiled holds the ASTs of the specs and actions that were type checked
+	compiled *Compiled
+}
+
+func NewTypeChecker(builtIns *BuiltIns) engine.TypeChecker {
+	return &TypeChecker{
+		BuiltIns: ownBuiltIns(builtIns),
+		compiled: newCompiled(),
+	}
+}
+
+// Compiled returns the ASTs of the specs and actions that were type checked so far.
+func (c *TypeChecker) Compiled() engine.Compiled {
+	if c.compiled == nil {
+		return nil
+	}
+
+	return c.compiled
+}
+
+// TypeCheckFunction type checks a user-defined function and makes it available
+// to the specs and actions that are checked afterwards.
+func (c *TypeChecker) TypeCheckFunction(function engine.PadFunction, line int) error {
+	source := "function"
+
+	def, positions, err := parseFunctionDef(function.Spec)
+	if err != nil {
+		return newDiagnostic(source, line, function.Spec, positions, err)
+	}
+
+	source = fmt.Sprintf("function %v", def.name)
+
+	err = declareFunction(c.BuiltIns, def)
+	if err != nil {
+		return newDiagnostic(source, line, function.Spec, positions, err)
+	}
+
+	c.compiled.addFunction(function.Spec, def)
+
+	return nil
+}
+
+func (c *TypeChecker) TypeCheckGroup(group engine.PadGroup, line int) error {
+	source := fmt.Sprintf("group %v", group.Name)
+
+	spec := group.Spec
+	if engine.GroupType(group.Type) == engine.GroupTypeFilter {
+		spec = group.Where
+	}
+
+	exprAST, positions, err := parse(spec)
+	if err != nil {
+		return newDiagnostic(source, line, spec, positions, err)
+	}
+
+	if engine.GroupType(group.Type) == engine.GroupTypeFilter {
+		exprAST, err = BuildFilter(group.Param, exprAST)
+		if err != nil {
+			return newDiagnostic(source, line, spec, positions, err)
+		}
+	}
+
+	exprType, err := exprAST.typeinfer(newTypeEnvFromBuiltIns(c.BuiltIns))
+	if err != nil {
+		return newDiagnostic(source, line, spec, positions, err)
+	}
+
+	if exprType == nil || (exprType.Kind() != ARRAY_TYPE && exprType.Kind() != ARRAY_OF_TYPE) {
+		err = &TypeError{
+			Expr:     exprAST,
+			Expected: BuildArrayOfType(BuildStringType()),
+			Actual:   exprType,
+			Message:  "expression is not a valid group",
+		}
+		return newDiagnostic(source, line, spec, positions, err)
+	}
+
+	c.compiled.addGroup(groupSource{engine.GroupType(group.Type), group.Spec, group.Param, group.Where}, exprAST)
+
+	return nil
+}
+
+func (c *TypeChecker) TypeCheckRule(rule engine.PadRule, line int) error {
+	source := fmt.Sprintf("rule %v", rule.Name)
+
+	exprAST, positions, err := parse(rule.Spec)
+	if err != nil {
+		return newDiagnostic(source, line, rule.Spec, positions, err)
+	}
+
+	exprType, err := exprAST.typeinfer(newTypeEnvFromBuiltIns(c.BuiltIns))
+	if err != nil {
+		return newDiagnostic(source, line, rule.Spec, positions, err)
+	}
+
+	if exprType == nil || exprType.Kind() != BOOL_TYPE {
+		err = &TypeError{
+			Expr:     exprAST,
+			Expected: BuildBoolType(),
+			Actual:   exprType,
+			Message:  fmt.Sprintf("expression %v is not a condition", rule.Spec),
+		}
+		return newDiagnostic(source, line, rule.Spec, positions, err)
+	}
+
+	c.compiled.addCondition(rule.Spec, exprAST)
+
+	return nil
+}
+
+func (c *TypeChecker) TypeCheckAction(workflowName, action string, line int) error {
+	source := fmt.Sprintf("workflow %v", workflowName)
+
+	exprAST, positions, err := parse(action)
+	if err != nil {
+		return newDiagnostic(source, line, action, positions, err)
+	}
+
+	if exprAST.Kind() != FUNCTION_CALL_CONST {
+		err = &TypeError{
+			Expr:    exprAST,
+			Message: fmt.Sprintf("expression %v is not an action", action),
+		}
+		return newDiagnostic(source, line, action, positions, err)
+	}
+
+	actionName := exprAST.(*FunctionCall).name.ident
+	if _, ok := c.BuiltIns.Actions[actionName]; !ok {
+		err = &TypeError{
+			Expr:    exprAST,
+			Message: fmt.Sprintf("%v is not a built-in action", actionName),
+		}
+		return newDiagnostic(source, line, action, positions, err)
+	}
+
+	_, err = exprAST.typeinfer(newTypeEnvFromBuiltIns(c.BuiltIns))
+	if err != nil {
+		return newDiagnostic(source, line, action, positions, err)
+	}
+
+	c.compiled.addAction(action, exprAST.(*FunctionCall))
+
+	return nil
+}
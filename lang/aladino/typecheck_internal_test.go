@@ -0,0 +1,257 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package aladino
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/reviewpad/reviewpad/v3/engine"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewTypeChecker(t *testing.T) {
+	builtIns := MockBuiltIns()
+
+	wantTypeChecker := &TypeChecker{BuiltIns: builtIns, compiled: newCompiled()}
+
+	gotTypeChecker := NewTypeChecker(builtIns)
+
+	assert.Equal(t, wantTypeChecker, gotTypeChecker)
+}
+
+func TestTypeCheckGroup_WhenParseFails(t *testing.T) {
+	typeChecker := NewTypeChecker(MockBuiltIns())
+
+	err := typeChecker.TypeCheckGroup(engine.PadGroup{
+		Name: "seniors",
+		Spec: "[\"john\"",
+	}, 4)
+
+	wantDiagnostic := &Diagnostic{
+		Source:  "group seniors",
+		Line:    4,
+		Column:  8,
+		Spec:    "[\"john\"",
+		Message: "syntax error: unexpected $end, expecting ']'",
+		offset:  7,
+	}
+
+	assert.Equal(t, wantDiagnostic, err)
+}
+
+func TestTypeCheckGroup_WhenSpecIsNotAGroup(t *testing.T) {
+	typeChecker := NewTypeChecker(MockBuiltIns())
+
+	err := typeChecker.TypeCheckGroup(engine.PadGroup{
+		Name: "seniors",
+		Spec: "$zeroConst()",
+	}, 4)
+
+	wantDiagnostic := &Diagnostic{
+		Source:   "group seniors",
+		Line:     4,
+		Column:   1,
+		Expected: BuildArrayOfType(BuildStringType()),
+		Actual:   BuildIntType(),
+		Spec:     "$zeroConst()",
+		Message:  "expression is not a valid group",
+	}
+
+	assert.Equal(t, wantDiagnostic, err)
+}
+
+func TestTypeCheckGroup(t *testing.T) {
+	typeChecker := NewTypeChecker(MockBuiltIns())
+
+	err := typeChecker.TypeCheckGroup(engine.PadGroup{
+		Name: "seniors",
+		Spec: "[\"john\", $returnStr(\"jane\")]",
+	}, 4)
+
+	assert.Nil(t, err)
+}
+
+func TestTypeCheckRule_WhenTypeInferenceFails(t *testing.T) {
+	typeChecker := NewTypeChecker(MockBuiltIns())
+
+	err := typeChecker.TypeCheckRule(engine.PadRule{
+		Name: "is-zero",
+		Spec: "$zeroConst() > \"10\"",
+	}, 12)
+
+	wantDiagnostic := &Diagnostic{
+		Source:   "rule is-zero",
+		Line:     12,
+		Column:   16,
+		Expected: BuildIntType(),
+		Actual:   BuildStringType(),
+		Spec:     "$zeroConst() > \"10\"",
+		Message:  "type inference failed",
+		offset:   15,
+	}
+
+	assert.Equal(t, wantDiagnostic, err)
+}
+
+func TestTypeCheckRule_WhenSpecIsNotACondition(t *testing.T) {
+	typeChecker := NewTypeChecker(MockBuiltIns())
+
+	err := typeChecker.TypeCheckRule(engine.PadRule{
+		Name: "is-zero",
+		Spec: "$zeroConst()",
+	}, 12)
+
+	wantDiagnostic := &Diagnostic{
+		Source:   "rule is-zero",
+		Line:     12,
+		Column:   1,
+		Expected: BuildBoolType(),
+		Actual:   BuildIntType(),
+		Spec:     "$zeroConst()",
+		Message:  "expression $zeroConst() is not a condition",
+	}
+
+	assert.Equal(t, wantDiagnostic, err)
+}
+
+func TestTypeCheckRule_WhenSpecIsAnAction(t *testing.T) {
+	typeChecker := NewTypeChecker(MockBuiltIns())
+
+	err := typeChecker.TypeCheckRule(engine.PadRule{
+		Name: "is-empty",
+		Spec: "$emptyAction()",
+	}, 0)
+
+	var diagnostic *Diagnostic
+
+	assert.True(t, errors.As(err, &diagnostic))
+	assert.Equal(t, "expression $emptyAction() is not a condition", diagnostic.Message)
+	assert.Nil(t, diagnostic.Actual)
+}
+
+func TestTypeCheckRule(t *testing.T) {
+	typeChecker := NewTypeChecker(MockBuiltIns())
+
+	err := typeChecker.TypeCheckRule(engine.PadRule{
+		Name: "is-zero",
+		Spec: "$zeroConst() == 0",
+	}, 12)
+
+	assert.Nil(t, err)
+}
+
+func TestTypeCheckAction_WhenExprIsNotFunctionCall(t *testing.T) {
+	typeChecker := NewTypeChecker(MockBuiltIns())
+
+	err := typeChecker.TypeCheckAction("test", "\"not an action\"", 20)
+
+	assert.EqualError(t, err, "workflow test (line 20, column 1): expression \"not an action\" is not an action\n\t\"not an action\"\n\t^")
+}
+
+func TestTypeCheckAction_WhenFunctionIsNotAnAction(t *testing.T) {
+	typeChecker := NewTypeChecker(MockBuiltIns())
+
+	err := typeChecker.TypeCheckAction("test", "$zeroConst()", 20)
+
+	assert.EqualError(t, err, "workflow test (line 20, column 1): zeroConst is not a built-in action\n\t$zeroConst()\n\t^")
+}
+
+func TestTypeCheckAction_WhenTypeInferenceFails(t *testing.T) {
+	typeChecker := NewTypeChecker(MockBuiltIns())
+
+	err := typeChecker.TypeCheckAction("test", "$emptyAction(1)", 20)
+
+	wantDiagnostic := &Diagnostic{
+		Source:   "workflow test",
+		Line:     20,
+		Column:   1,
+		Expected: BuildFunctionType([]Type{}, nil),
+		Actual:   BuildFunctionType([]Type{BuildIntType()}, nil),
+		Spec:     "$emptyAction(1)",
+		Message:  "type inference failed: mismatch in arg types on emptyAction",
+	}
+
+	assert.Equal(t, wantDiagnostic, err)
+}
+
+func TestTypeCheckAction(t *testing.T) {
+	typeChecker := NewTypeChecker(MockBuiltIns())
+
+	err := typeChecker.TypeCheckAction("test", "$emptyAction()", 20)
+
+	assert.Nil(t, err)
+}
+
+func TestTypeCheckFunction(t *testing.T) {
+	typeChecker := NewTypeChecker(MockBuiltIns())
+
+	err := typeChecker.TypeCheckFunction(engine.PadFunction{
+		Spec: "isZero(n: Int): Bool = $n == 0",
+	}, 3)
+	assert.Nil(t, err)
+
+	err = typeChecker.TypeCheckRule(engine.PadRule{
+		Name: "is-zero",
+		Spec: "$isZero($zeroConst())",
+	}, 7)
+	assert.Nil(t, err)
+}
+
+func TestTypeCheckFunction_DoesNotChangeTheBuiltIns(t *testing.T) {
+	builtIns := MockBuiltIns()
+	function := engine.PadFunction{
+		Spec: "isZero(n: Int): Bool = $n == 0",
+	}
+
+	err := NewTypeChecker(builtIns).TypeCheckFunction(function, 3)
+	assert.Nil(t, err)
+
+	// The built-ins can be reused to type check the same reviewpad file again
+	err = NewTypeChecker(builtIns).TypeCheckFunction(function, 3)
+	assert.Nil(t, err)
+
+	assert.Nil(t, builtIns.Functions["isZero"])
+}
+
+func TestTypeCheckFunction_WhenParseFails(t *testing.T) {
+	typeChecker := NewTypeChecker(MockBuiltIns())
+
+	err := typeChecker.TypeCheckFunction(engine.PadFunction{
+		Spec: "isZero(n): Bool = $n == 0",
+	}, 3)
+
+	wantDiagnostic := &Diagnostic{
+		Source:  "function",
+		Line:    3,
+		Column:  9,
+		Spec:    "isZero(n): Bool = $n == 0",
+		Message: "syntax error: unexpected ')', expecting ':'",
+		offset:  8,
+	}
+
+	assert.Equal(t, wantDiagnostic, err)
+}
+
+func TestTypeCheckFunction_WhenBodyDoesNotMatchReturnType(t *testing.T) {
+	typeChecker := NewTypeChecker(MockBuiltIns())
+
+	err := typeChecker.TypeCheckFunction(engine.PadFunction{
+		Spec: "isZero(n: Int): Bool = $n",
+	}, 3)
+
+	wantDiagnostic := &Diagnostic{
+		Source:   "function isZero",
+		Line:     3,
+		Column:   24,
+		Expected: BuildBoolType(),
+		Actual:   BuildIntType(),
+		Spec:     "isZero(n: Int): Bool = $n",
+		Message:  "type inference failed: body of isZero does not match its return type",
+		offset:   23,
+	}
+
+	assert.Equal(t, wantDiagnostic, err)
+}
@@ -4,7 +4,31 @@
 
 package aladino
 
-import "fmt"
+import (
+	"fmt"
+)
+
+// TypeError is returned when an expression is not well typed.
+// Expected and Actual are nil when the error is not a type mismatch.
+type TypeError struct {
+	Expr     Expr
+	Expected Type
+	Actual   Type
+	Message  string
+}
+
+func (e *TypeError) Error() string {
+	return e.Message
+}
+
+func typeMismatchError(expr Expr, expected, actual Type) *TypeError {
+	return &TypeError{
+		Expr:     expr,
+		Expected: expected,
+		Actual:   actual,
+		Message:  "type inference failed",
+	}
+}
 
 func TypeInference(e Env, expr Expr) (Type, error) {
 	return expr.typeinfer(NewTypeEnv(e))
@@ -32,11 +56,17 @@ func (u *UnaryOp) typeinfer(env TypeEnv) (Type, error) {
 
 	switch u.op.getOperator() {
 	case NOT_OP:
-		if exprType.Kind() == BOOL_TYPE {
+		if exprType.Kind() == BOOL_TYPE || isJSON(exprType) {
 			return BuildBoolType(), nil
 		}
+		return nil, typeMismatchError(u.expr, BuildBoolType(), exprType)
+	case NEG_OP:
+		if isNumeric(exprType) || isJSON(exprType) {
+			return exprType, nil
+		}
+		return nil, typeMismatchError(u.expr, BuildIntType(), exprType)
 	}
-	return nil, fmt.Errorf("type inference failed")
+	return nil, typeMismatchError(u, nil, nil)
 }
 
 func (b *BinaryOp) typeinfer(env TypeEnv) (Type, error) {
@@ -50,22 +80,245 @@ func (b *BinaryOp) typeinfer(env TypeEnv) (Type, error) {
 		return nil, errRight
 	}
 
+	lhsType, rhsType = jsonOperandTypes(b.op, lhsType, rhsType)
+
 	switch b.op.getOperator() {
 	case EQ_OP, NEQ_OP:
-		if lhsType.equals(rhsType) {
+		if lhsType.equals(rhsType) || (isNumeric(lhsType) && isNumeric(rhsType)) {
 			return BuildBoolType(), nil
 		}
+		return nil, typeMismatchError(b.rhs, lhsType, rhsType)
 	case GREATER_EQ_THAN_OP, GREATER_THAN_OP, LESS_EQ_THAN_OP, LESS_THAN_OP:
-		if lhsType.equals(BuildIntType()) && rhsType.equals(BuildIntType()) {
+		if !isOrdered(lhsType) {
+			return nil, typeMismatchError(b.lhs, BuildIntType(), lhsType)
+		}
+		if isNumeric(lhsType) && isNumeric(rhsType) {
 			return BuildBoolType(), nil
 		}
+		if !rhsType.equals(lhsType) {
+			return nil, typeMismatchError(b.rhs, lhsType, rhsType)
+		}
+		return BuildBoolType(), nil
 	case AND_OP, OR_OP:
-		if lhsType.equals(BuildBoolType()) && rhsType.equals(BuildBoolType()) {
-			return BuildBoolType(), nil
+		err := checkOperands(b, BuildBoolType(), lhsType, rhsType)
+		if err != nil {
+			return nil, err
+		}
+		return BuildBoolType(), nil
+	case ADD_OP, SUB_OP:
+		if isTimeOrDuration(lhsType) {
+			return timeArithmeticType(b, lhsType, rhsType)
+		}
+		return numericType(b, lhsType, rhsType)
+	case MUL_OP:
+		return numericType(b, lhsType, rhsType)
+	case DIV_OP:
+		// The division of numbers is always a float (e.g. 1 / 2 = 0.5), $floor and $round turn it back into an int
+		_, err := numericType(b, lhsType, rhsType)
+		if err != nil {
+			return nil, err
+		}
+		return BuildFloatType(), nil
+	case MOD_OP:
+		// The remainder of a float division is a float (e.g. 5 / 2 % 2 = 0.5)
+		return numericType(b, lhsType, rhsType)
+	case MATCH_OP, NOT_MATCH_OP:
+		err := checkOperands(b, BuildStringType(), lhsType, rhsType)
+		if err != nil {
+			return nil, err
+		}
+		// Constant patterns are compiled once, so invalid ones are reported right away
+		if pattern, ok := b.rhs.(*StringConst); ok {
+			regex, err := compilePattern(pattern.value)
+			if err != nil {
+				return nil, &TypeError{
+					Expr:    b.rhs,
+					Message: err.Error(),
+				}
+			}
+			b.op.(regexOperator).setPattern(regex)
 		}
+		return BuildBoolType(), nil
+	case IN_OP:
+		arrayType := BuildArrayOfType(lhsType)
+		if !arrayType.equals(rhsType) {
+			return nil, typeMismatchError(b.rhs, arrayType, rhsType)
+		}
+		return BuildBoolType(), nil
 	}
 
-	return nil, fmt.Errorf("type inference failed")
+	return nil, typeMismatchError(b, nil, nil)
+}
+
+func (c *Conditional) typeinfer(env TypeEnv) (Type, error) {
+	conditionType, err := c.condition.typeinfer(env)
+	if err != nil {
+		return nil, err
+	}
+
+	if !conditionType.equals(BuildBoolType()) {
+		return nil, typeMismatchError(c.condition, BuildBoolType(), conditionType)
+	}
+
+	thenType, err := c.thenExpr.typeinfer(env)
+	if err != nil {
+		return nil, err
+	}
+
+	elseType, err := c.elseExpr.typeinfer(env)
+	if err != nil {
+		return nil, err
+	}
+
+	if ty, ok := joinTypes(thenType, elseType); ok {
+		return ty, nil
+	}
+
+	return nil, typeMismatchError(c.elseExpr, thenType, elseType)
+}
+
+// joinTypes returns the type that covers both branches of a conditional.
+// Array literals with different lengths (e.g. ["a"] and ["b", "c"]) are joined into an array of their element type.
+func joinTypes(thenType, elseType Type) (Type, bool) {
+	if thenType.equals(elseType) {
+		return thenType, true
+	}
+
+	thenElemType, thenOk := arrayElemType(thenType)
+	elseElemType, elseOk := arrayElemType(elseType)
+	if !thenOk || !elseOk {
+		return nil, false
+	}
+
+	switch {
+	case thenElemType == nil:
+		return elseType, true
+	case elseElemType == nil:
+		return thenType, true
+	case thenElemType.equals(elseElemType):
+		return BuildArrayOfType(thenElemType), true
+	}
+
+	return nil, false
+}
+
+// arrayElemType returns the type of the elements of an array when all of them have the same type.
+// The element type of the empty array literal is nil.
+func arrayElemType(ty Type) (Type, bool) {
+	switch arrayTy := ty.(type) {
+	case *ArrayOfType:
+		return arrayTy.elemType, true
+	case *ArrayType:
+		if len(arrayTy.elemsType) == 0 {
+			return nil, true
+		}
+		elemType := arrayTy.elemsType[0]
+		if BuildArrayOfType(elemType).equals(arrayTy) {
+			return elemType, true
+		}
+	}
+
+	return nil, false
+}
+
+func isJSON(ty Type) bool {
+	return ty != nil && ty.Kind() == JSON_TYPE
+}
+
+// jsonOperandTypes returns the types of the operands of a binary operation where a JSON operand
+// has the type expected by the other operand (e.g. Int in $event("pull_request.number") > 3).
+// The operands are checked to have compatible kinds when the operation is evaluated.
+func jsonOperandTypes(op BinaryOperator, lhsType, rhsType Type) (Type, Type) {
+	if lhsType == nil || rhsType == nil || isJSON(lhsType) == isJSON(rhsType) {
+		return lhsType, rhsType
+	}
+
+	if op.getOperator() == IN_OP {
+		if isJSON(rhsType) {
+			return lhsType, BuildArrayOfType(lhsType)
+		}
+
+		if elemType, ok := arrayElemType(rhsType); ok && elemType != nil {
+			return elemType, rhsType
+		}
+
+		return lhsType, rhsType
+	}
+
+	if isJSON(lhsType) {
+		return rhsType, rhsType
+	}
+
+	return lhsType, lhsType
+}
+
+// isOrdered checks if the values of the type can be compared with <, <=, > and >=.
+func isOrdered(ty Type) bool {
+	return isNumeric(ty) || isTimeOrDuration(ty) || ty.Kind() == STRING_TYPE
+}
+
+func isNumeric(ty Type) bool {
+	return ty.Kind() == INT_TYPE || ty.Kind() == FLOAT_TYPE
+}
+
+// numericType returns the type of an arithmetic operation on numbers.
+// An int is promoted to a float when the other operand is a float.
+func numericType(b *BinaryOp, lhsType, rhsType Type) (Type, error) {
+	if !isNumeric(lhsType) {
+		return nil, typeMismatchError(b.lhs, BuildIntType(), lhsType)
+	}
+
+	if !isNumeric(rhsType) {
+		return nil, typeMismatchError(b.rhs, lhsType, rhsType)
+	}
+
+	if lhsType.Kind() == FLOAT_TYPE || rhsType.Kind() == FLOAT_TYPE {
+		return BuildFloatType(), nil
+	}
+
+	return BuildIntType(), nil
+}
+
+func isTimeOrDuration(ty Type) bool {
+	return ty.Kind() == TIME_TYPE || ty.Kind() == DURATION_TYPE
+}
+
+// timeArithmeticType returns the type of adding or subtracting times and durations:
+//
+//	Time + Duration = Time
+//	Time - Duration = Time
+//	Time - Time = Duration
+//	Duration + Time = Time
+//	Duration + Duration = Duration
+//	Duration - Duration = Duration
+func timeArithmeticType(b *BinaryOp, lhsType, rhsType Type) (Type, error) {
+	isSub := b.op.getOperator() == SUB_OP
+
+	switch {
+	case lhsType.Kind() == TIME_TYPE && rhsType.Kind() == DURATION_TYPE:
+		return BuildTimeType(), nil
+	case lhsType.Kind() == TIME_TYPE && rhsType.Kind() == TIME_TYPE && isSub:
+		return BuildDurationType(), nil
+	case lhsType.Kind() == DURATION_TYPE && rhsType.Kind() == TIME_TYPE && !isSub:
+		return BuildTimeType(), nil
+	case lhsType.Kind() == DURATION_TYPE && rhsType.Kind() == DURATION_TYPE:
+		return BuildDurationType(), nil
+	}
+
+	return nil, typeMismatchError(b.rhs, BuildDurationType(), rhsType)
+}
+
+// checkOperands checks that both operands of a binary operation have the operandType.
+func checkOperands(b *BinaryOp, operandType, lhsType, rhsType Type) error {
+	if !lhsType.equals(operandType) {
+		return typeMismatchError(b.lhs, operandType, lhsType)
+	}
+
+	if !rhsType.equals(operandType) {
+		return typeMismatchError(b.rhs, operandType, rhsType)
+	}
+
+	return nil
 }
 
 func (fc *FunctionCall) typeinfer(env TypeEnv) (Type, error) {
@@ -79,12 +332,65 @@ func (fc *FunctionCall) typeinfer(env TypeEnv) (Type, error) {
 		return nil, err
 	}
 
-	ty := fcType.(*FunctionType)
-	if equals(argsTy, ty.paramTypes) {
-		return ty.returnType, nil
+	ty, ok := fcType.(*FunctionType)
+	if !ok {
+		return nil, &TypeError{
+			Expr:    fc,
+			Actual:  fcType,
+			Message: fmt.Sprintf("type inference failed: %v is not a function", fc.name.ident),
+		}
+	}
+
+	// The type variables of polymorphic built-ins are bound by the arguments of each call
+	subst := make(substitution)
+
+	typeErr := &TypeError{
+		Expr:     fc,
+		Expected: ty,
+		Actual:   BuildFunctionType(argsTy, ty.returnType),
+		Message:  fmt.Sprintf("type inference failed: mismatch in arg types on %v", fc.name.ident),
+	}
+
+	// The arguments of the variadic parameter are matched one after the other
+	callTy := ty.expand(len(fc.arguments))
+
+	argIndexes, err := matchArguments(fc, callTy)
+	if err == errTooManyArguments {
+		return nil, typeErr
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for i, argIndex := range argIndexes {
+		if argIndex == -1 {
+			// Only optional parameters can be left without argument
+			if callTy.defaultValue(i) == nil {
+				return nil, typeErr
+			}
+			continue
+		}
+
+		paramTy := callTy.paramTypes[i]
+		argTy := argsTy[argIndex]
+		if !unify(paramTy, argTy, subst) {
+			// Point to the first argument with the wrong type
+			typeErr.Expr = fc.arguments[argIndex]
+			typeErr.Expected = substitute(paramTy, subst)
+			typeErr.Actual = argTy
+			return nil, typeErr
+		}
+	}
+
+	returnType := substitute(ty.returnType, subst)
+	if returnType != nil && hasTypeVars(returnType) {
+		return nil, &TypeError{
+			Expr:    fc,
+			Message: fmt.Sprintf("type inference failed: cannot infer the return type of %v", fc.name.ident),
+		}
 	}
 
-	return nil, fmt.Errorf("type inference failed: mismatch in arg types on %v", fc.name.ident)
+	return returnType, nil
 }
 
 func (l *Lambda) typeinfer(env TypeEnv) (Type, error) {
@@ -93,7 +399,29 @@ func (l *Lambda) typeinfer(env TypeEnv) (Type, error) {
 		return nil, err
 	}
 
-	bodyType, err := l.body.typeinfer(env)
+	// The parameters are only visible in the body of the lambda
+	// where they shadow the variables of the enclosing scope
+	bindings := make(TypeEnv, len(l.parameters))
+	for i, param := range l.parameters {
+		if param.Kind() != TYPED_EXPR {
+			return nil, &TypeError{
+				Expr:    param,
+				Message: fmt.Sprintf("lambda parameter %v is not a typed variable", param),
+			}
+		}
+
+		paramIdent := param.(*TypedExpr).expr.(*Variable).ident
+		if _, ok := bindings[paramIdent]; ok {
+			return nil, &TypeError{
+				Expr:    param,
+				Message: fmt.Sprintf("duplicate lambda parameter %v", paramIdent),
+			}
+		}
+
+		bindings[paramIdent] = paramsTy[i]
+	}
+
+	bodyType, err := l.body.typeinfer(env.extend(bindings))
 	if err != nil {
 		return nil, err
 	}
@@ -101,23 +429,29 @@ func (l *Lambda) typeinfer(env TypeEnv) (Type, error) {
 	return BuildFunctionType(paramsTy, bodyType), nil
 }
 
+func (na *NamedArg) typeinfer(env TypeEnv) (Type, error) {
+	return na.value.typeinfer(env)
+}
+
 func (te *TypedExpr) typeinfer(env TypeEnv) (Type, error) {
 	if te.expr.Kind() != VARIABLE_CONST {
-		return nil, fmt.Errorf("typed expression %v is not a variable", te.expr)
+		return nil, &TypeError{
+			Expr:    te,
+			Message: fmt.Sprintf("typed expression %v is not a variable", te.expr),
+		}
 	}
 
-	varIdent := te.expr.(*Variable).ident
-	env[varIdent] = te.typeOf
-
 	return te.typeOf, nil
 }
 
-// TODO: Fix variable shadowing
 func (v *Variable) typeinfer(env TypeEnv) (Type, error) {
 	varName := v.ident
 	varType, ok := env[varName]
 	if !ok {
-		return nil, fmt.Errorf("no type for built-in %v. Please check if the mode in the reviewpad.yml file supports it", varName)
+		return nil, &TypeError{
+			Expr:    v,
+			Message: fmt.Sprintf("no type for built-in %v. Please check if the mode in the reviewpad.yml file supports it", varName),
+		}
 	}
 
 	return varType, nil
@@ -131,6 +465,22 @@ func (i *IntConst) typeinfer(env TypeEnv) (Type, error) {
 	return BuildIntType(), nil
 }
 
+func (f *FloatConst) typeinfer(env TypeEnv) (Type, error) {
+	return BuildFloatType(), nil
+}
+
+func (t *TimeConst) typeinfer(env TypeEnv) (Type, error) {
+	return BuildTimeType(), nil
+}
+
+func (r *RelativeTimeConst) typeinfer(env TypeEnv) (Type, error) {
+	return BuildTimeType(), nil
+}
+
+func (d *DurationConst) typeinfer(env TypeEnv) (Type, error) {
+	return BuildDurationType(), nil
+}
+
 func (b *BoolConst) typeinfer(env TypeEnv) (Type, error) {
 	return BuildBoolType(), nil
 }
@@ -6,6 +6,7 @@ package aladino
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -120,6 +121,263 @@ func TestTypeInfer_WhenUnaryOpOperatorIsANotOp(t *testing.T) {
 	assert.Equal(t, wantType, gotType)
 }
 
+func TestTypeInfer_WhenUnaryOpOperatorIsANegOp(t *testing.T) {
+	mockedTypeEnv := MockTypeEnv()
+
+	unaryOp := BuildNegOp(BuildIntConst(1))
+	gotType, err := unaryOp.typeinfer(mockedTypeEnv)
+
+	wantType := BuildIntType()
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantType, gotType)
+}
+
+func TestTypeInfer_WhenNegOpOperandIsNotInt(t *testing.T) {
+	mockedTypeEnv := MockTypeEnv()
+
+	unaryOp := BuildNegOp(BuildBoolConst(true))
+	gotType, err := unaryOp.typeinfer(mockedTypeEnv)
+
+	assert.Nil(t, gotType)
+	assert.EqualError(t, err, "type inference failed")
+}
+
+func TestTypeInfer_WhenNegOpOperandIsFloat(t *testing.T) {
+	mockedTypeEnv := MockTypeEnv()
+
+	unaryOp := BuildNegOp(BuildFloatConst(1.5))
+	gotType, err := unaryOp.typeinfer(mockedTypeEnv)
+
+	wantType := BuildFloatType()
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantType, gotType)
+}
+
+func TestTypeInfer_WhenAddOpOperandsAreIntAndFloat(t *testing.T) {
+	mockedTypeEnv := MockTypeEnv()
+
+	binaryOp := BuildBinaryOp(BuildIntConst(1), &AddOp{}, BuildFloatConst(0.5))
+	gotType, err := binaryOp.typeinfer(mockedTypeEnv)
+
+	wantType := BuildFloatType()
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantType, gotType)
+}
+
+func TestTypeInfer_WhenDivOpOperandsAreInts(t *testing.T) {
+	mockedTypeEnv := MockTypeEnv()
+
+	binaryOp := BuildBinaryOp(BuildIntConst(1), &DivOp{}, BuildIntConst(2))
+	gotType, err := binaryOp.typeinfer(mockedTypeEnv)
+
+	wantType := BuildFloatType()
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantType, gotType)
+}
+
+func TestTypeInfer_WhenModOpOperandIsFloat(t *testing.T) {
+	mockedTypeEnv := MockTypeEnv()
+
+	binaryOp := BuildBinaryOp(BuildIntConst(1), &ModOp{}, BuildFloatConst(1.5))
+	gotType, err := binaryOp.typeinfer(mockedTypeEnv)
+
+	wantType := BuildFloatType()
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantType, gotType)
+}
+
+func TestTypeInfer_WhenModOpOperandIsDivOp(t *testing.T) {
+	mockedTypeEnv := MockTypeEnv()
+
+	binaryOp := BuildBinaryOp(BuildBinaryOp(BuildIntConst(10), &DivOp{}, BuildIntConst(2)), &ModOp{}, BuildIntConst(3))
+	gotType, err := binaryOp.typeinfer(mockedTypeEnv)
+
+	wantType := BuildFloatType()
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantType, gotType)
+}
+
+func TestTypeInfer_WhenComparingIntAndFloat(t *testing.T) {
+	mockedTypeEnv := MockTypeEnv()
+
+	binaryOp := BuildBinaryOp(BuildIntConst(1), &LessThanOp{}, BuildFloatConst(1.5))
+	gotType, err := binaryOp.typeinfer(mockedTypeEnv)
+
+	wantType := BuildBoolType()
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantType, gotType)
+}
+
+func TestTypeInfer_WhenComparingStrings(t *testing.T) {
+	mockedTypeEnv := MockTypeEnv()
+
+	binaryOp := BuildBinaryOp(BuildStringConst("a"), &LessThanOp{}, BuildStringConst("b"))
+	gotType, err := binaryOp.typeinfer(mockedTypeEnv)
+
+	wantType := BuildBoolType()
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantType, gotType)
+}
+
+func TestTypeInfer_WhenMatchOp(t *testing.T) {
+	mockedTypeEnv := MockTypeEnv()
+
+	binaryOp := BuildMatchOp(BuildStringConst("fix: typo"), BuildStringConst("^(feat|fix): "))
+	gotType, err := binaryOp.typeinfer(mockedTypeEnv)
+
+	wantType := BuildBoolType()
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantType, gotType)
+}
+
+func TestTypeInfer_WhenMatchOpPatternIsConst(t *testing.T) {
+	mockedTypeEnv := MockTypeEnv()
+
+	binaryOp := BuildNotMatchOp(BuildStringConst("fix: typo"), BuildStringConst("^WIP"))
+	_, err := binaryOp.typeinfer(mockedTypeEnv)
+
+	wantPattern := regexp.MustCompile("^WIP")
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantPattern, binaryOp.op.(*NotMatchOp).pattern)
+}
+
+func TestTypeInfer_WhenMatchOpOperandIsNotString(t *testing.T) {
+	mockedTypeEnv := MockTypeEnv()
+
+	binaryOp := BuildNotMatchOp(BuildIntConst(1), BuildStringConst("1"))
+	gotType, err := binaryOp.typeinfer(mockedTypeEnv)
+
+	assert.Nil(t, gotType)
+	assert.EqualError(t, err, "type inference failed")
+}
+
+func TestTypeInfer_WhenMatchOpHasInvalidRegex(t *testing.T) {
+	mockedTypeEnv := MockTypeEnv()
+
+	pattern := BuildStringConst("^(feat|fix")
+	binaryOp := BuildMatchOp(BuildStringConst("fix: typo"), pattern)
+	gotType, err := binaryOp.typeinfer(mockedTypeEnv)
+
+	wantErr := &TypeError{
+		Expr:    pattern,
+		Message: "invalid regular expression: error parsing regexp: missing closing ): `^(feat|fix`",
+	}
+
+	assert.Nil(t, gotType)
+	assert.Equal(t, wantErr, err)
+}
+
+func TestTypeInfer_WhenInOp(t *testing.T) {
+	mockedTypeEnv := MockTypeEnv()
+
+	binaryOp := BuildInOp(BuildStringConst("john"), BuildArray([]Expr{BuildStringConst("john"), BuildStringConst("jane")}))
+	gotType, err := binaryOp.typeinfer(mockedTypeEnv)
+
+	wantType := BuildBoolType()
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantType, gotType)
+}
+
+func TestTypeInfer_WhenInOpElementsHaveDiffType(t *testing.T) {
+	mockedTypeEnv := MockTypeEnv()
+
+	array := BuildArray([]Expr{BuildIntConst(1)})
+	binaryOp := BuildInOp(BuildStringConst("john"), array)
+	gotType, err := binaryOp.typeinfer(mockedTypeEnv)
+
+	wantErr := &TypeError{
+		Expr:     array,
+		Expected: BuildArrayOfType(BuildStringType()),
+		Actual:   BuildArrayType([]Type{BuildIntType()}),
+		Message:  "type inference failed",
+	}
+
+	assert.Nil(t, gotType)
+	assert.Equal(t, wantErr, err)
+}
+
+func TestTypeInfer_WhenComparingJSONAndInt(t *testing.T) {
+	mockedTypeEnv := MockTypeEnv().extend(TypeEnv{
+		"event": BuildFunctionType([]Type{BuildStringType()}, BuildJSONType()),
+	})
+
+	event := BuildFunctionCall(BuildVariable("event"), []Expr{BuildStringConst("pull_request.number")})
+	binaryOp := BuildBinaryOp(event, eqOperator(), BuildIntConst(3))
+	gotType, err := binaryOp.typeinfer(mockedTypeEnv)
+
+	wantType := BuildBoolType()
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantType, gotType)
+}
+
+func TestTypeInfer_WhenConditional(t *testing.T) {
+	mockedTypeEnv := MockTypeEnv()
+
+	conditional := BuildConditional(BuildBoolConst(true), BuildIntConst(1), BuildIntConst(2))
+	gotType, err := conditional.typeinfer(mockedTypeEnv)
+
+	wantType := BuildIntType()
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantType, gotType)
+}
+
+func TestTypeInfer_WhenConditionalBranchesAreArraysOfDiffLengths(t *testing.T) {
+	mockedTypeEnv := MockTypeEnv()
+
+	conditional := BuildConditional(
+		BuildBoolConst(true),
+		BuildArray([]Expr{BuildStringConst("john")}),
+		BuildArray([]Expr{BuildStringConst("jane"), BuildStringConst("mary")}),
+	)
+	gotType, err := conditional.typeinfer(mockedTypeEnv)
+
+	wantType := BuildArrayOfType(BuildStringType())
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantType, gotType)
+}
+
+func TestTypeInfer_WhenConditionIsNotBool(t *testing.T) {
+	mockedTypeEnv := MockTypeEnv()
+
+	conditional := BuildConditional(BuildIntConst(1), BuildIntConst(1), BuildIntConst(2))
+	gotType, err := conditional.typeinfer(mockedTypeEnv)
+
+	assert.Nil(t, gotType)
+	assert.EqualError(t, err, "type inference failed")
+}
+
+func TestTypeInfer_WhenConditionalBranchesHaveDiffTypes(t *testing.T) {
+	mockedTypeEnv := MockTypeEnv()
+
+	elseExpr := BuildStringConst("2")
+	conditional := BuildConditional(BuildBoolConst(true), BuildIntConst(1), elseExpr)
+	gotType, err := conditional.typeinfer(mockedTypeEnv)
+
+	wantErr := &TypeError{
+		Expr:     elseExpr,
+		Expected: BuildIntType(),
+		Actual:   BuildStringType(),
+		Message:  "type inference failed",
+	}
+
+	assert.Nil(t, gotType)
+	assert.Equal(t, wantErr, err)
+}
+
 func TestTypeInfer_WhenBinaryOpLhsHasError(t *testing.T) {
 	mockedTypeEnv := MockTypeEnv()
 
@@ -291,6 +549,89 @@ func TestTypeInfer_WhenFunctionCallHasMismatchInArgTypes(t *testing.T) {
 	assert.EqualError(t, err, "type inference failed: mismatch in arg types on returnStr")
 }
 
+// polymorphicTypeEnv has the types of polymorphic built-ins such as the ones of $length and $map
+func polymorphicTypeEnv() TypeEnv {
+	a := BuildTypeVar("a")
+	b := BuildTypeVar("b")
+
+	return MockTypeEnv().extend(TypeEnv{
+		"length":      BuildFunctionType([]Type{BuildArrayOfType(a)}, BuildIntType()),
+		"isElementOf": BuildFunctionType([]Type{a, BuildArrayOfType(a)}, BuildBoolType()),
+		"map": BuildFunctionType(
+			[]Type{BuildArrayOfType(a), BuildFunctionType([]Type{a}, b)},
+			BuildArrayOfType(b),
+		),
+		"first": BuildFunctionType([]Type{BuildArrayOfType(a)}, a),
+	})
+}
+
+func TestTypeInfer_WhenFunctionCallIsPolymorphic(t *testing.T) {
+	typeEnv := polymorphicTypeEnv()
+
+	fc := BuildFunctionCall(
+		BuildVariable("length"),
+		[]Expr{BuildArray([]Expr{BuildIntConst(1), BuildIntConst(2)})},
+	)
+	gotType, err := fc.typeinfer(typeEnv)
+
+	wantType := BuildIntType()
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantType, gotType)
+}
+
+func TestTypeInfer_WhenFunctionCallReturnTypeIsPolymorphic(t *testing.T) {
+	typeEnv := polymorphicTypeEnv()
+
+	fc := BuildFunctionCall(
+		BuildVariable("map"),
+		[]Expr{
+			BuildArray([]Expr{BuildStringConst("a"), BuildStringConst("b")}),
+			BuildLambda(
+				[]Expr{BuildTypedExpr(BuildVariable("x"), BuildStringType())},
+				BuildBinaryOp(BuildVariable("x"), &EqOp{}, BuildStringConst("a")),
+			),
+		},
+	)
+	gotType, err := fc.typeinfer(typeEnv)
+
+	wantType := BuildArrayOfType(BuildBoolType())
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantType, gotType)
+}
+
+func TestTypeInfer_WhenFunctionCallTypeVarIsBoundTwice(t *testing.T) {
+	typeEnv := polymorphicTypeEnv()
+
+	array := BuildArray([]Expr{BuildStringConst("a")})
+	fc := BuildFunctionCall(
+		BuildVariable("isElementOf"),
+		[]Expr{BuildIntConst(1), array},
+	)
+	gotType, err := fc.typeinfer(typeEnv)
+
+	wantErr := &TypeError{
+		Expr:     array,
+		Expected: BuildArrayOfType(BuildIntType()),
+		Actual:   BuildArrayType([]Type{BuildStringType()}),
+		Message:  "type inference failed: mismatch in arg types on isElementOf",
+	}
+
+	assert.Nil(t, gotType)
+	assert.Equal(t, wantErr, err)
+}
+
+func TestTypeInfer_WhenFunctionCallReturnTypeCannotBeInferred(t *testing.T) {
+	typeEnv := polymorphicTypeEnv()
+
+	fc := BuildFunctionCall(BuildVariable("first"), []Expr{BuildArray([]Expr{})})
+	gotType, err := fc.typeinfer(typeEnv)
+
+	assert.Nil(t, gotType)
+	assert.EqualError(t, err, "type inference failed: cannot infer the return type of first")
+}
+
 func TestTypeInfer_WhenLambdaParamTypeHasError(t *testing.T) {
 	mockedTypeEnv := MockTypeEnv()
 
@@ -365,14 +706,72 @@ func TestTypeInfer_WhenTypedExprHasCorrectTypes(t *testing.T) {
 	typedExpr := BuildTypedExpr(BuildVariable(variableName), BuildIntType())
 	gotType, err := typedExpr.typeinfer(mockedTypeEnv)
 
-	gotStoredType, ok := mockedTypeEnv[variableName]
+	_, ok := mockedTypeEnv[variableName]
 
 	wantType := BuildIntType()
 
 	assert.Nil(t, err)
 	assert.Equal(t, wantType, gotType)
-	assert.True(t, ok)
-	assert.Equal(t, wantType, gotStoredType)
+	assert.False(t, ok)
+}
+
+func TestTypeInfer_WhenLambdaParamIsNotATypedExpr(t *testing.T) {
+	mockedTypeEnv := MockTypeEnv()
+
+	lambda := BuildLambda(
+		[]Expr{BuildVariable("zeroConst")},
+		BuildIntConst(1),
+	)
+	gotType, err := lambda.typeinfer(mockedTypeEnv)
+
+	assert.Nil(t, gotType)
+	assert.EqualError(t, err, fmt.Sprintf("lambda parameter %v is not a typed variable", BuildVariable("zeroConst")))
+}
+
+func TestTypeInfer_WhenLambdaHasDuplicateParams(t *testing.T) {
+	mockedTypeEnv := MockTypeEnv()
+
+	lambda := BuildLambda(
+		[]Expr{
+			BuildTypedExpr(BuildVariable("x"), BuildStringType()),
+			BuildTypedExpr(BuildVariable("x"), BuildIntType()),
+		},
+		BuildVariable("x"),
+	)
+	gotType, err := lambda.typeinfer(mockedTypeEnv)
+
+	assert.Nil(t, gotType)
+	assert.EqualError(t, err, "duplicate lambda parameter x")
+}
+
+func TestTypeInfer_WhenLambdaParamShadowsBuiltIn(t *testing.T) {
+	mockedTypeEnv := MockTypeEnv()
+
+	lambda := BuildLambda(
+		[]Expr{BuildTypedExpr(BuildVariable("zeroConst"), BuildStringType())},
+		BuildVariable("zeroConst"),
+	)
+	gotType, err := lambda.typeinfer(mockedTypeEnv)
+
+	wantType := BuildFunctionType([]Type{BuildStringType()}, BuildStringType())
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantType, gotType)
+	assert.Equal(t, BuildFunctionType([]Type{}, BuildIntType()), mockedTypeEnv["zeroConst"])
+}
+
+func TestTypeInfer_WhenLambdaParamIsUsedOutsideOfLambda(t *testing.T) {
+	mockedTypeEnv := MockTypeEnv()
+
+	lambda := BuildLambda(
+		[]Expr{BuildTypedExpr(BuildVariable("x"), BuildStringType())},
+		BuildVariable("x"),
+	)
+	array := BuildArray([]Expr{lambda, BuildVariable("x")})
+	gotType, err := array.typeinfer(mockedTypeEnv)
+
+	assert.Nil(t, gotType)
+	assert.EqualError(t, err, "no type for built-in x. Please check if the mode in the reviewpad.yml file supports it")
 }
 
 func TestTypeInfer_WhenVariableIsNotABuiltIn(t *testing.T) {
@@ -422,6 +821,18 @@ func TestTypeInfer_WhenIntConst(t *testing.T) {
 	assert.Equal(t, wantType, gotType)
 }
 
+func TestTypeInfer_WhenFloatConst(t *testing.T) {
+	mockedTypeEnv := MockTypeEnv()
+
+	floatConst := BuildFloatConst(0.5)
+	gotType, err := floatConst.typeinfer(mockedTypeEnv)
+
+	wantType := BuildFloatType()
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantType, gotType)
+}
+
 func TestTypeInfer_WhenBoolConst(t *testing.T) {
 	mockedTypeEnv := MockTypeEnv()
 
@@ -455,3 +866,160 @@ func TestTypeInfer_WhenArrayElemsTypeHasCorrectTypes(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, wantType, gotType)
 }
+
+func TestTypeInfer_WhenBinaryOpHasArithmeticOperator(t *testing.T) {
+	mockedTypeEnv := MockTypeEnv()
+
+	binaryOp := BuildAddOp(BuildIntConst(1), BuildModOp(BuildIntConst(5), BuildIntConst(2)))
+	gotType, err := binaryOp.typeinfer(mockedTypeEnv)
+
+	wantType := BuildIntType()
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantType, gotType)
+}
+
+func TestTypeInfer_WhenArithmeticOperandIsNotInt(t *testing.T) {
+	mockedTypeEnv := MockTypeEnv()
+
+	binaryOp := BuildMulOp(BuildIntConst(1), BuildStringConst("2"))
+	gotType, err := binaryOp.typeinfer(mockedTypeEnv)
+
+	assert.Nil(t, gotType)
+	assert.EqualError(t, err, "type inference failed")
+}
+
+func TestTypeInfer_WhenTimeConst(t *testing.T) {
+	mockedTypeEnv := MockTypeEnv()
+
+	gotType, err := (&TimeConst{1649116800}).typeinfer(mockedTypeEnv)
+
+	assert.Nil(t, err)
+	assert.Equal(t, BuildTimeType(), gotType)
+}
+
+func TestTypeInfer_WhenRelativeTimeConst(t *testing.T) {
+	mockedTypeEnv := MockTypeEnv()
+
+	gotType, err := (&RelativeTimeConst{3, "day"}).typeinfer(mockedTypeEnv)
+
+	assert.Nil(t, err)
+	assert.Equal(t, BuildTimeType(), gotType)
+}
+
+func TestTypeInfer_WhenDurationConst(t *testing.T) {
+	mockedTypeEnv := MockTypeEnv()
+
+	gotType, err := BuildDurationConst(60).typeinfer(mockedTypeEnv)
+
+	assert.Nil(t, err)
+	assert.Equal(t, BuildDurationType(), gotType)
+}
+
+func TestTypeInfer_WhenTimesAreCompared(t *testing.T) {
+	mockedTypeEnv := MockTypeEnv()
+
+	binaryOp := BuildLessThanOp(&TimeConst{1649116800}, &RelativeTimeConst{3, "day"})
+	gotType, err := binaryOp.typeinfer(mockedTypeEnv)
+
+	assert.Nil(t, err)
+	assert.Equal(t, BuildBoolType(), gotType)
+}
+
+func TestTypeInfer_WhenTimeIsComparedToInt(t *testing.T) {
+	mockedTypeEnv := MockTypeEnv()
+
+	binaryOp := BuildLessThanOp(&TimeConst{1649116800}, BuildIntConst(1))
+	gotType, err := binaryOp.typeinfer(mockedTypeEnv)
+
+	assert.Nil(t, gotType)
+	assert.Equal(t, typeMismatchError(binaryOp.rhs, BuildTimeType(), BuildIntType()), err)
+}
+
+func TestTypeInfer_WhenTimeIsSubtractedFromTime(t *testing.T) {
+	mockedTypeEnv := MockTypeEnv()
+
+	binaryOp := BuildSubOp(&TimeConst{1649116800}, &TimeConst{1648771200})
+	gotType, err := binaryOp.typeinfer(mockedTypeEnv)
+
+	assert.Nil(t, err)
+	assert.Equal(t, BuildDurationType(), gotType)
+}
+
+func TestTypeInfer_WhenDurationIsAddedToTime(t *testing.T) {
+	mockedTypeEnv := MockTypeEnv()
+
+	binaryOp := BuildAddOp(BuildDurationConst(60), &TimeConst{1648771200})
+	gotType, err := binaryOp.typeinfer(mockedTypeEnv)
+
+	assert.Nil(t, err)
+	assert.Equal(t, BuildTimeType(), gotType)
+}
+
+func TestTypeInfer_WhenTimeIsAddedToTime(t *testing.T) {
+	mockedTypeEnv := MockTypeEnv()
+
+	binaryOp := BuildAddOp(&TimeConst{1649116800}, &TimeConst{1648771200})
+	gotType, err := binaryOp.typeinfer(mockedTypeEnv)
+
+	assert.Nil(t, gotType)
+	assert.Equal(t, typeMismatchError(binaryOp.rhs, BuildDurationType(), BuildTimeType()), err)
+}
+
+func TestTypeInfer_WhenTimeIsSubtractedFromDuration(t *testing.T) {
+	mockedTypeEnv := MockTypeEnv()
+
+	binaryOp := BuildSubOp(BuildDurationConst(60), &TimeConst{1648771200})
+	gotType, err := binaryOp.typeinfer(mockedTypeEnv)
+
+	assert.Nil(t, gotType)
+	assert.Equal(t, typeMismatchError(binaryOp.rhs, BuildDurationType(), BuildTimeType()), err)
+}
+
+func TestTypeInfer_WhenVariadicFunctionCall(t *testing.T) {
+	typeEnv := MockTypeEnv().extend(TypeEnv{
+		"concat": BuildVariadicFunctionType([]Type{BuildStringType()}, BuildStringType()),
+	})
+
+	expr, err := Parse(`$concat("a", $returnStr("b"), "c")`)
+	if err != nil {
+		assert.FailNow(t, "parse failed: %v", err)
+	}
+
+	gotType, err := expr.typeinfer(typeEnv)
+
+	assert.Nil(t, err)
+	assert.Equal(t, BuildStringType(), gotType)
+}
+
+func TestTypeInfer_WhenVariadicArgumentHasWrongType(t *testing.T) {
+	typeEnv := MockTypeEnv().extend(TypeEnv{
+		"concat": BuildVariadicFunctionType([]Type{BuildStringType()}, BuildStringType()),
+	})
+
+	arg := BuildIntConst(1)
+	fc := BuildFunctionCall(BuildVariable("concat"), []Expr{BuildStringConst("a"), BuildStringConst("b"), arg})
+	gotType, err := fc.typeinfer(typeEnv)
+
+	wantErr := &TypeError{
+		Expr:     arg,
+		Expected: BuildStringType(),
+		Actual:   BuildIntType(),
+		Message:  "type inference failed: mismatch in arg types on concat",
+	}
+
+	assert.Nil(t, gotType)
+	assert.Equal(t, wantErr, err)
+}
+
+func TestTypeInfer_WhenVariadicFunctionCallHasNoArguments(t *testing.T) {
+	typeEnv := MockTypeEnv().extend(TypeEnv{
+		"concat": BuildVariadicFunctionType([]Type{BuildStringType()}, BuildStringType()),
+	})
+
+	fc := BuildFunctionCall(BuildVariable("concat"), []Expr{})
+	gotType, err := fc.typeinfer(typeEnv)
+
+	assert.Nil(t, gotType)
+	assert.EqualError(t, err, "type inference failed: mismatch in arg types on concat")
+}
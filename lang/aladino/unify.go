@@ -0,0 +1,148 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package aladino
+
+// substitution maps the type variables of a polymorphic type to the types they stand for.
+type substitution map[string]Type
+
+// unify checks if the type ty is an instance of the (possibly polymorphic) type pattern.
+// The type variables of pattern that are bound along the way are recorded in subst.
+// For instance, unifying []a with []String binds a to String.
+// A JSON type unifies with any type since JSON values are checked when the call is evaluated (see bindArguments).
+func unify(pattern, ty Type, subst substitution) bool {
+	if ty == nil || pattern == nil {
+		return ty == nil && pattern == nil
+	}
+
+	if ty.Kind() == JSON_TYPE {
+		bindJSON(pattern, subst)
+		return true
+	}
+
+	if pattern.Kind() == JSON_TYPE {
+		return true
+	}
+
+	switch patternTy := pattern.(type) {
+	case *TypeVar:
+		if boundTy, ok := subst[patternTy.name]; ok {
+			return unify(boundTy, ty, subst)
+		}
+		// Array literals (e.g. ["a", "b"]) stand for arrays of any length
+		if elemTy, ok := arrayElemType(ty); ok && elemTy != nil {
+			ty = BuildArrayOfType(elemTy)
+		}
+		subst[patternTy.name] = ty
+		return true
+	case *ArrayOfType:
+		switch arrayTy := ty.(type) {
+		case *ArrayOfType:
+			return unify(patternTy.elemType, arrayTy.elemType, subst)
+		case *ArrayType:
+			for _, elemTy := range arrayTy.elemsType {
+				if !unify(patternTy.elemType, elemTy, subst) {
+					return false
+				}
+			}
+			return true
+		}
+		return false
+	case *MapType:
+		mapTy, ok := ty.(*MapType)
+		return ok && unify(patternTy.valueType, mapTy.valueType, subst)
+	case *FunctionType:
+		fnTy, ok := ty.(*FunctionType)
+		if !ok || len(patternTy.paramTypes) != len(fnTy.paramTypes) || patternTy.variadic != fnTy.variadic {
+			return false
+		}
+		for i, paramTy := range patternTy.paramTypes {
+			if !unify(paramTy, fnTy.paramTypes[i], subst) {
+				return false
+			}
+		}
+		return unify(patternTy.returnType, fnTy.returnType, subst)
+	}
+
+	return pattern.equals(ty)
+}
+
+// substitute replaces the type variables of ty that are bound in subst.
+func substitute(ty Type, subst substitution) Type {
+	switch polyTy := ty.(type) {
+	case *TypeVar:
+		if boundTy, ok := subst[polyTy.name]; ok {
+			return boundTy
+		}
+	case *ArrayOfType:
+		return BuildArrayOfType(substitute(polyTy.elemType, subst))
+	case *ArrayType:
+		elemsTy := make([]Type, len(polyTy.elemsType))
+		for i, elemTy := range polyTy.elemsType {
+			elemsTy[i] = substitute(elemTy, subst)
+		}
+		return BuildArrayType(elemsTy)
+	case *MapType:
+		return BuildMapType(substitute(polyTy.valueType, subst))
+	case *FunctionType:
+		paramsTy := make([]Type, len(polyTy.paramTypes))
+		for i, paramTy := range polyTy.paramTypes {
+			paramsTy[i] = substitute(paramTy, subst)
+		}
+		return &FunctionType{paramsTy, substitute(polyTy.returnType, subst), polyTy.paramNames, polyTy.defaults, polyTy.variadic}
+	}
+
+	return ty
+}
+
+// hasTypeVars checks if ty is polymorphic, i.e. mentions type variables.
+func hasTypeVars(ty Type) bool {
+	switch polyTy := ty.(type) {
+	case *TypeVar:
+		return true
+	case *ArrayOfType:
+		return hasTypeVars(polyTy.elemType)
+	case *ArrayType:
+		for _, elemTy := range polyTy.elemsType {
+			if hasTypeVars(elemTy) {
+				return true
+			}
+		}
+	case *MapType:
+		return hasTypeVars(polyTy.valueType)
+	case *FunctionType:
+		for _, paramTy := range polyTy.paramTypes {
+			if hasTypeVars(paramTy) {
+				return true
+			}
+		}
+		return hasTypeVars(polyTy.returnType)
+	}
+
+	return false
+}
+
+// bindJSON binds the type variables of pattern that are not bound yet to the JSON type.
+// For instance, unifying Map[a] with JSON binds a to JSON.
+func bindJSON(pattern Type, subst substitution) {
+	switch polyTy := pattern.(type) {
+	case *TypeVar:
+		if _, ok := subst[polyTy.name]; !ok {
+			subst[polyTy.name] = BuildJSONType()
+		}
+	case *ArrayOfType:
+		bindJSON(polyTy.elemType, subst)
+	case *ArrayType:
+		for _, elemTy := range polyTy.elemsType {
+			bindJSON(elemTy, subst)
+		}
+	case *MapType:
+		bindJSON(polyTy.valueType, subst)
+	case *FunctionType:
+		for _, paramTy := range polyTy.paramTypes {
+			bindJSON(paramTy, subst)
+		}
+		bindJSON(polyTy.returnType, subst)
+	}
+}
@@ -0,0 +1,142 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package aladino
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnify_WhenTypeVar(t *testing.T) {
+	subst := make(substitution)
+
+	ok := unify(BuildTypeVar("a"), BuildIntType(), subst)
+
+	assert.True(t, ok)
+	assert.Equal(t, substitution{"a": BuildIntType()}, subst)
+}
+
+func TestUnify_WhenTypeVarIsBoundToArrayLiteral(t *testing.T) {
+	subst := make(substitution)
+
+	ok := unify(BuildTypeVar("a"), BuildArrayType([]Type{BuildIntType(), BuildIntType()}), subst)
+
+	assert.True(t, ok)
+	assert.Equal(t, substitution{"a": BuildArrayOfType(BuildIntType())}, subst)
+}
+
+func TestUnify_WhenTypeVarIsAlreadyBound(t *testing.T) {
+	subst := substitution{"a": BuildStringType()}
+
+	ok := unify(BuildTypeVar("a"), BuildIntType(), subst)
+
+	assert.False(t, ok)
+}
+
+func TestUnify_WhenArrayLiteral(t *testing.T) {
+	subst := make(substitution)
+
+	ok := unify(
+		BuildArrayOfType(BuildTypeVar("a")),
+		BuildArrayType([]Type{BuildBoolType(), BuildBoolType()}),
+		subst,
+	)
+
+	assert.True(t, ok)
+	assert.Equal(t, substitution{"a": BuildBoolType()}, subst)
+}
+
+func TestUnify_WhenArrayLiteralHasDiffElemTypes(t *testing.T) {
+	subst := make(substitution)
+
+	ok := unify(
+		BuildArrayOfType(BuildTypeVar("a")),
+		BuildArrayType([]Type{BuildBoolType(), BuildIntType()}),
+		subst,
+	)
+
+	assert.False(t, ok)
+}
+
+func TestUnify_WhenMapType(t *testing.T) {
+	subst := make(substitution)
+
+	ok := unify(BuildMapType(BuildTypeVar("a")), BuildMapType(BuildStringType()), subst)
+
+	assert.True(t, ok)
+	assert.Equal(t, substitution{"a": BuildStringType()}, subst)
+}
+
+func TestUnify_WhenFunctionType(t *testing.T) {
+	subst := make(substitution)
+
+	ok := unify(
+		BuildFunctionType([]Type{BuildTypeVar("a")}, BuildTypeVar("b")),
+		BuildFunctionType([]Type{BuildStringType()}, BuildIntType()),
+		subst,
+	)
+
+	assert.True(t, ok)
+	assert.Equal(t, substitution{"a": BuildStringType(), "b": BuildIntType()}, subst)
+}
+
+func TestUnify_WhenFunctionTypeHasDiffArity(t *testing.T) {
+	subst := make(substitution)
+
+	ok := unify(
+		BuildFunctionType([]Type{BuildTypeVar("a")}, BuildBoolType()),
+		BuildFunctionType([]Type{}, BuildBoolType()),
+		subst,
+	)
+
+	assert.False(t, ok)
+}
+
+func TestUnify_WhenJSONType(t *testing.T) {
+	subst := make(substitution)
+
+	ok := unify(BuildMapType(BuildTypeVar("a")), BuildMapType(BuildJSONType()), subst)
+
+	assert.True(t, ok)
+	assert.Equal(t, substitution{"a": BuildJSONType()}, subst)
+}
+
+func TestUnify_WhenTypeIsJSON(t *testing.T) {
+	subst := make(substitution)
+
+	ok := unify(BuildArrayOfType(BuildTypeVar("a")), BuildJSONType(), subst)
+
+	assert.True(t, ok)
+	assert.Equal(t, substitution{"a": BuildJSONType()}, subst)
+}
+
+func TestUnify_WhenTypesAreMonomorphic(t *testing.T) {
+	subst := make(substitution)
+
+	assert.True(t, unify(BuildStringType(), BuildStringType(), subst))
+	assert.False(t, unify(BuildStringType(), BuildIntType(), subst))
+	assert.Empty(t, subst)
+}
+
+func TestSubstitute(t *testing.T) {
+	subst := substitution{"a": BuildStringType()}
+
+	gotType := substitute(
+		BuildFunctionType([]Type{BuildArrayOfType(BuildTypeVar("a"))}, BuildMapType(BuildTypeVar("b"))),
+		subst,
+	)
+
+	wantType := BuildFunctionType([]Type{BuildArrayOfType(BuildStringType())}, BuildMapType(BuildTypeVar("b")))
+
+	assert.Equal(t, wantType, gotType)
+}
+
+func TestHasTypeVars(t *testing.T) {
+	assert.True(t, hasTypeVars(BuildArrayOfType(BuildTypeVar("a"))))
+	assert.True(t, hasTypeVars(BuildFunctionType([]Type{}, BuildTypeVar("a"))))
+	assert.False(t, hasTypeVars(BuildMapType(BuildStringType())))
+	assert.False(t, hasTypeVars(nil))
+}
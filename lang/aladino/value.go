@@ -12,10 +12,13 @@ type Value interface {
 
 const (
 	INT_VALUE      string = "IntValue"
+	FLOAT_VALUE    string = "FloatValue"
 	BOOL_VALUE     string = "BoolValue"
 	STRING_VALUE   string = "StringValue"
 	TIME_VALUE     string = "TimeValue"
+	DURATION_VALUE string = "DurationValue"
 	ARRAY_VALUE    string = "ArrayValue"
+	MAP_VALUE      string = "MapValue"
 	FUNCTION_VALUE string = "FunctionValue"
 )
 
@@ -44,6 +47,31 @@ func (thisVal *IntValue) Equals(other Value) bool {
 	return thisVal.Val == other.(*IntValue).Val
 }
 
+// FloatValue represents a floating point value
+type FloatValue struct {
+	Val float64
+}
+
+func BuildFloatValue(fVal float64) *FloatValue {
+	return &FloatValue{Val: fVal}
+}
+
+func (fVal *FloatValue) Kind() string {
+	return FLOAT_VALUE
+}
+
+func (fVal *FloatValue) HasKindOf(ty string) bool {
+	return fVal.Kind() == ty
+}
+
+func (thisVal *FloatValue) Equals(other Value) bool {
+	if thisVal.Kind() != other.Kind() {
+		return false
+	}
+
+	return thisVal.Val == other.(*FloatValue).Val
+}
+
 // BoolValue represents a bool value
 type BoolValue struct {
 	// defaultValue
@@ -99,6 +127,7 @@ func (sVal *StringValue) HasKindOf(ty string) bool {
 	return sVal.Kind() == ty
 }
 
+// TimeValue represents an instant in time as a Unix time in seconds
 type TimeValue struct {
 	Val int
 }
@@ -125,6 +154,33 @@ func (thisVal *TimeValue) Equals(other Value) bool {
 	return thisVal.Val == other.(*TimeValue).Val
 }
 
+// DurationValue represents a duration in seconds
+type DurationValue struct {
+	Val int
+}
+
+func BuildDurationValue(dVal int) *DurationValue {
+	return &DurationValue{
+		Val: dVal,
+	}
+}
+
+func (dVal *DurationValue) Kind() string {
+	return DURATION_VALUE
+}
+
+func (dVal *DurationValue) HasKindOf(kind string) bool {
+	return dVal.Kind() == kind
+}
+
+func (thisVal *DurationValue) Equals(other Value) bool {
+	if thisVal.Kind() != other.Kind() {
+		return false
+	}
+
+	return thisVal.Val == other.(*DurationValue).Val
+}
+
 // ArrayValue represents an array value
 type ArrayValue struct {
 	// defaultValue
@@ -164,13 +220,51 @@ func (aVal *ArrayValue) HasKindOf(ty string) bool {
 	return aVal.Kind() == ty
 }
 
+// MapValue represents a map from strings to values
+type MapValue struct {
+	Vals map[string]Value
+}
+
+func BuildMapValue(vals map[string]Value) *MapValue {
+	return &MapValue{Vals: vals}
+}
+
+func (mVal *MapValue) Kind() string {
+	return MAP_VALUE
+}
+
+func (thisVal *MapValue) Equals(other Value) bool {
+	if thisVal.Kind() != other.Kind() {
+		return false
+	}
+
+	otherMap := other.(*MapValue)
+
+	if len(thisVal.Vals) != len(otherMap.Vals) {
+		return false
+	}
+
+	for key, val := range thisVal.Vals {
+		otherVal, ok := otherMap.Vals[key]
+		if !ok || !val.Equals(otherVal) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (mVal *MapValue) HasKindOf(ty string) bool {
+	return mVal.Kind() == ty
+}
+
 // FunctionValue represents a function value
 type FunctionValue struct {
 	// defaultValue
-	Fn func(args []Value) Value
+	Fn func(args []Value) (Value, error)
 }
 
-func BuildFunctionValue(fn func(args []Value) Value) *FunctionValue {
+func BuildFunctionValue(fn func(args []Value) (Value, error)) *FunctionValue {
 	return &FunctionValue{fn}
 }
 
@@ -21,6 +21,14 @@ func TestBuildIntValue(t *testing.T) {
 	assert.Equal(t, wantVal, gotVal)
 }
 
+func TestBuildFloatValue(t *testing.T) {
+	wantVal := &aladino.FloatValue{Val: 0.5}
+
+	gotVal := aladino.BuildFloatValue(0.5)
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
 func TestBuildTrueValue(t *testing.T) {
 	wantVal := aladino.BuildBoolValue(true)
 
@@ -63,6 +71,14 @@ func TestBuildTimeValue(t *testing.T) {
 	assert.Equal(t, wantVal, gotVal)
 }
 
+func TestBuildDurationValue(t *testing.T) {
+	wantVal := &aladino.DurationValue{Val: 1}
+
+	gotVal := aladino.BuildDurationValue(1)
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
 func TestBuildArrayValue(t *testing.T) {
 	elVals := []aladino.Value{aladino.BuildIntValue(1)}
 	wantVal := &aladino.ArrayValue{Vals: elVals}
@@ -72,9 +88,18 @@ func TestBuildArrayValue(t *testing.T) {
 	assert.Equal(t, wantVal, gotVal)
 }
 
+func TestBuildMapValue(t *testing.T) {
+	vals := map[string]aladino.Value{"ref": aladino.BuildStringValue("main")}
+	wantVal := &aladino.MapValue{Vals: vals}
+
+	gotVal := aladino.BuildMapValue(vals)
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
 func TestBuildFunctionValue(t *testing.T) {
-	fn := func(args []aladino.Value) aladino.Value {
-		return &aladino.IntValue{Val: 0}
+	fn := func(args []aladino.Value) (aladino.Value, error) {
+		return &aladino.IntValue{Val: 0}, nil
 	}
 
 	wantVal := &aladino.FunctionValue{fn}
@@ -122,6 +147,15 @@ func TestTimeValueKind(t *testing.T) {
 	assert.Equal(t, wantVal, gotVal)
 }
 
+func TestDurationValueKind(t *testing.T) {
+	wantVal := aladino.DURATION_VALUE
+
+	durationVal := &aladino.DurationValue{Val: 1}
+	gotVal := durationVal.Kind()
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
 func TestArrayValueKind(t *testing.T) {
 	wantVal := aladino.ARRAY_VALUE
 
@@ -135,8 +169,8 @@ func TestFunctionValueKind(t *testing.T) {
 	wantVal := aladino.FUNCTION_VALUE
 
 	fnVal := &aladino.FunctionValue{
-		func(args []aladino.Value) aladino.Value {
-			return &aladino.IntValue{Val: 0}
+		func(args []aladino.Value) (aladino.Value, error) {
+			return &aladino.IntValue{Val: 0}, nil
 		},
 	}
 	gotVal := fnVal.Kind()
@@ -152,6 +186,21 @@ func TestIntValueHasKindOf(t *testing.T) {
 	assert.True(t, intVal.HasKindOf(aladino.INT_VALUE))
 }
 
+func TestFloatValueKind(t *testing.T) {
+	wantVal := aladino.FLOAT_VALUE
+
+	floatVal := &aladino.FloatValue{Val: 0.5}
+	gotVal := floatVal.Kind()
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestFloatValueHasKindOf(t *testing.T) {
+	floatVal := &aladino.FloatValue{Val: 0.5}
+
+	assert.True(t, floatVal.HasKindOf(aladino.FLOAT_VALUE))
+}
+
 func TestBoolValueHasKindOf(t *testing.T) {
 	boolVal := &aladino.BoolValue{Val: true}
 
@@ -170,6 +219,27 @@ func TestTimeValueHasKindOf(t *testing.T) {
 	assert.True(t, timeVal.HasKindOf(aladino.TIME_VALUE))
 }
 
+func TestDurationValueHasKindOf(t *testing.T) {
+	durationVal := &aladino.DurationValue{Val: 1}
+
+	assert.True(t, durationVal.HasKindOf(aladino.DURATION_VALUE))
+}
+
+func TestMapValueKind(t *testing.T) {
+	wantVal := aladino.MAP_VALUE
+
+	mapVal := &aladino.MapValue{Vals: map[string]aladino.Value{}}
+	gotVal := mapVal.Kind()
+
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestMapValueHasKindOf(t *testing.T) {
+	mapVal := &aladino.MapValue{Vals: map[string]aladino.Value{}}
+
+	assert.True(t, mapVal.HasKindOf(aladino.MAP_VALUE))
+}
+
 func TestArrayValueHasKindOf(t *testing.T) {
 	arrayVal := &aladino.ArrayValue{Vals: []aladino.Value{}}
 
@@ -178,8 +248,8 @@ func TestArrayValueHasKindOf(t *testing.T) {
 
 func TestFunctionValueHasKindOf(t *testing.T) {
 	fnVal := &aladino.FunctionValue{
-		func(args []aladino.Value) aladino.Value {
-			return &aladino.IntValue{Val: 0}
+		func(args []aladino.Value) (aladino.Value, error) {
+			return &aladino.IntValue{Val: 0}, nil
 		},
 	}
 
@@ -209,6 +279,20 @@ func TestIntValueEquals_WhenFalse(t *testing.T) {
 	assert.False(t, intVal.Equals(otherVal))
 }
 
+func TestFloatValueEquals_WhenDiffKinds(t *testing.T) {
+	floatVal := &aladino.FloatValue{Val: 1}
+	otherVal := &aladino.IntValue{Val: 1}
+
+	assert.False(t, floatVal.Equals(otherVal))
+}
+
+func TestFloatValueEquals_WhenTrue(t *testing.T) {
+	floatVal := &aladino.FloatValue{Val: 0.5}
+	otherVal := &aladino.FloatValue{Val: 0.5}
+
+	assert.True(t, floatVal.Equals(otherVal))
+}
+
 func TestBoolValueEquals_WhenDiffKinds(t *testing.T) {
 	boolVal := &aladino.BoolValue{Val: true}
 	otherVal := &aladino.IntValue{Val: 0}
@@ -272,6 +356,27 @@ func TestTimeValueEquals_WhenFalse(t *testing.T) {
 	assert.False(t, timeVal.Equals(otherVal))
 }
 
+func TestDurationValueEquals_WhenDiffKinds(t *testing.T) {
+	durationVal := &aladino.DurationValue{Val: 1}
+	otherVal := &aladino.TimeValue{Val: 1}
+
+	assert.False(t, durationVal.Equals(otherVal))
+}
+
+func TestDurationValueEquals_WhenTrue(t *testing.T) {
+	durationVal := &aladino.DurationValue{Val: 1}
+	otherVal := &aladino.DurationValue{Val: 1}
+
+	assert.True(t, durationVal.Equals(otherVal))
+}
+
+func TestDurationValueEquals_WhenFalse(t *testing.T) {
+	durationVal := &aladino.DurationValue{Val: 0}
+	otherVal := &aladino.DurationValue{Val: 1}
+
+	assert.False(t, durationVal.Equals(otherVal))
+}
+
 func TestArrayValueEquals_WhenDiffKinds(t *testing.T) {
 	arrayVal := &aladino.ArrayValue{Vals: []aladino.Value{}}
 	otherVal := &aladino.IntValue{Val: 0}
@@ -307,16 +412,44 @@ func TestArrayValueEquals_WhenFalse(t *testing.T) {
 	assert.False(t, arrayVal.Equals(otherVal))
 }
 
+func TestMapValueEquals_WhenDiffKinds(t *testing.T) {
+	mapVal := &aladino.MapValue{Vals: map[string]aladino.Value{}}
+	otherVal := &aladino.ArrayValue{Vals: []aladino.Value{}}
+
+	assert.False(t, mapVal.Equals(otherVal))
+}
+
+func TestMapValueEquals_WhenDiffKeys(t *testing.T) {
+	mapVal := &aladino.MapValue{Vals: map[string]aladino.Value{"ref": &aladino.StringValue{Val: "main"}}}
+	otherVal := &aladino.MapValue{Vals: map[string]aladino.Value{"sha": &aladino.StringValue{Val: "main"}}}
+
+	assert.False(t, mapVal.Equals(otherVal))
+}
+
+func TestMapValueEquals_WhenDiffValues(t *testing.T) {
+	mapVal := &aladino.MapValue{Vals: map[string]aladino.Value{"ref": &aladino.StringValue{Val: "main"}}}
+	otherVal := &aladino.MapValue{Vals: map[string]aladino.Value{"ref": &aladino.StringValue{Val: "dev"}}}
+
+	assert.False(t, mapVal.Equals(otherVal))
+}
+
+func TestMapValueEquals_WhenTrue(t *testing.T) {
+	mapVal := &aladino.MapValue{Vals: map[string]aladino.Value{"ref": &aladino.StringValue{Val: "main"}}}
+	otherVal := &aladino.MapValue{Vals: map[string]aladino.Value{"ref": &aladino.StringValue{Val: "main"}}}
+
+	assert.True(t, mapVal.Equals(otherVal))
+}
+
 func TestFunctionValueEquals_WhenTrue(t *testing.T) {
 	fnVal := &aladino.FunctionValue{
-		func(args []aladino.Value) aladino.Value {
-			return &aladino.IntValue{Val: 0}
+		func(args []aladino.Value) (aladino.Value, error) {
+			return &aladino.IntValue{Val: 0}, nil
 		},
 	}
 
 	otherVal := &aladino.FunctionValue{
-		func(args []aladino.Value) aladino.Value {
-			return &aladino.IntValue{Val: 0}
+		func(args []aladino.Value) (aladino.Value, error) {
+			return &aladino.IntValue{Val: 0}, nil
 		},
 	}
 
@@ -325,8 +458,8 @@ func TestFunctionValueEquals_WhenTrue(t *testing.T) {
 
 func TestFunctionValueEquals_WhenFalse(t *testing.T) {
 	fnVal := &aladino.FunctionValue{
-		func(args []aladino.Value) aladino.Value {
-			return &aladino.IntValue{Val: 0}
+		func(args []aladino.Value) (aladino.Value, error) {
+			return &aladino.IntValue{Val: 0}, nil
 		},
 	}
 
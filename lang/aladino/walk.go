@@ -0,0 +1,80 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package aladino
+
+// A Visitor's Visit method is invoked for each expression encountered by Walk.
+// If the result visitor w is not nil, Walk visits each of the children
+// of expr with the visitor w, followed by a call of w.Visit(nil).
+type Visitor interface {
+	Visit(expr Expr) (w Visitor)
+}
+
+// Walk traverses an AST in depth-first order: it starts by calling v.Visit(expr);
+// expr must not be nil. If the visitor w returned by v.Visit(expr) is not nil,
+// Walk is invoked recursively with visitor w for each of the non-nil children
+// of expr, followed by a call of w.Visit(nil).
+// The name of a function call is not visited as a variable (see FunctionCall.Name).
+func Walk(v Visitor, expr Expr) {
+	if v = v.Visit(expr); v == nil {
+		return
+	}
+
+	switch e := expr.(type) {
+	case *UnaryOp:
+		Walk(v, e.expr)
+	case *BinaryOp:
+		Walk(v, e.lhs)
+		Walk(v, e.rhs)
+	case *Conditional:
+		Walk(v, e.condition)
+		Walk(v, e.thenExpr)
+		Walk(v, e.elseExpr)
+	case *NamedArg:
+		Walk(v, e.value)
+	case *FunctionCall:
+		walkList(v, e.arguments)
+	case *Array:
+		walkList(v, e.elems)
+	case *TypedExpr:
+		Walk(v, e.expr)
+	case *Lambda:
+		walkList(v, e.parameters)
+		Walk(v, e.body)
+	}
+
+	v.Visit(nil)
+}
+
+func walkList(v Visitor, exprs []Expr) {
+	for _, expr := range exprs {
+		Walk(v, expr)
+	}
+}
+
+type inspector func(Expr) bool
+
+func (f inspector) Visit(expr Expr) Visitor {
+	if f(expr) {
+		return f
+	}
+
+	return nil
+}
+
+// Inspect traverses an AST in depth-first order: it starts by calling f(expr);
+// expr must not be nil. If f returns true, Inspect invokes f recursively for
+// each of the non-nil children of expr, followed by a call of f(nil).
+//
+// For instance, the built-ins called by a spec are collected with:
+//
+//	Inspect(expr, func(expr Expr) bool {
+//		if call, ok := expr.(*FunctionCall); ok {
+//			calls = append(calls, call.Name())
+//		}
+//		return true
+//	})
+func Inspect(expr Expr, f func(Expr) bool) {
+	Walk(inspector(f), expr)
+}
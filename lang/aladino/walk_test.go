@@ -0,0 +1,181 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package aladino_test
+
+import (
+	"testing"
+
+	"github.com/reviewpad/reviewpad/v3/lang/aladino"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInspect_CollectsFunctionCalls(t *testing.T) {
+	expr, err := aladino.Parse(`$size() > 10 && $isElementOf($author(), $group("seniors"))`)
+	if err != nil {
+		assert.FailNow(t, "parse failed: %v", err)
+	}
+
+	calls := make([]string, 0)
+	aladino.Inspect(expr, func(expr aladino.Expr) bool {
+		if call, ok := expr.(*aladino.FunctionCall); ok {
+			calls = append(calls, call.Name())
+		}
+		return true
+	})
+
+	assert.Equal(t, []string{"size", "isElementOf", "author", "group"}, calls)
+}
+
+func TestInspect_VisitsInDepthFirstOrder(t *testing.T) {
+	expr, err := aladino.Parse(`if !$a then [1, 2.5] else $f("x", n: 3d)`)
+	if err != nil {
+		assert.FailNow(t, "parse failed: %v", err)
+	}
+
+	kinds := make([]string, 0)
+	aladino.Inspect(expr, func(expr aladino.Expr) bool {
+		if expr == nil {
+			kinds = append(kinds, "end")
+		} else {
+			kinds = append(kinds, expr.Kind())
+		}
+		return true
+	})
+
+	wantKinds := []string{
+		"Conditional",
+		"UnaryOp", "Variable", "end", "end",
+		"Array", "IntConst", "end", "FloatConst", "end", "end",
+		"FunctionCall", "StringConst", "end", "NamedArg", "DurationConst", "end", "end", "end",
+		"end",
+	}
+
+	assert.Equal(t, wantKinds, kinds)
+}
+
+func TestInspect_WhenChildrenAreSkipped(t *testing.T) {
+	expr, err := aladino.Parse(`$filter($reviewers(), ($r: String => $startsWith($r, "bot"))) == []`)
+	if err != nil {
+		assert.FailNow(t, "parse failed: %v", err)
+	}
+
+	calls := make([]string, 0)
+	aladino.Inspect(expr, func(expr aladino.Expr) bool {
+		if call, ok := expr.(*aladino.FunctionCall); ok {
+			calls = append(calls, call.Name())
+		}
+		// Skip the body of the lambdas
+		_, isLambda := expr.(*aladino.Lambda)
+		return !isLambda
+	})
+
+	assert.Equal(t, []string{"filter", "reviewers"}, calls)
+}
+
+type countingVisitor struct {
+	total int
+}
+
+func (v *countingVisitor) Visit(expr aladino.Expr) aladino.Visitor {
+	if expr != nil {
+		v.total++
+	}
+	return v
+}
+
+func TestWalk(t *testing.T) {
+	expr, err := aladino.Parse(`($a: Int, $b: []Bool => $a + 1)`)
+	if err != nil {
+		assert.FailNow(t, "parse failed: %v", err)
+	}
+
+	visitor := &countingVisitor{}
+	aladino.Walk(visitor, expr)
+
+	// lambda, 2 typed params with their variables, + with its operands
+	assert.Equal(t, 8, visitor.total)
+}
+
+func TestAccessors(t *testing.T) {
+	expr, err := aladino.Parse(`$merge(method: "rebase") || 3 days ago < 2022-04-05 && -1 == 0.5`)
+	if err != nil {
+		assert.FailNow(t, "parse failed: %v", err)
+	}
+
+	or := expr.(*aladino.BinaryOp)
+	assert.Equal(t, "||", or.Operator())
+
+	merge := or.Lhs().(*aladino.FunctionCall)
+	assert.Equal(t, "merge", merge.Name())
+
+	method := merge.Arguments()[0].(*aladino.NamedArg)
+	assert.Equal(t, "method", method.Name())
+	assert.Equal(t, "rebase", method.Value().(*aladino.StringConst).Value())
+
+	and := or.Rhs().(*aladino.BinaryOp)
+	lessThan := and.Lhs().(*aladino.BinaryOp)
+	relativeTime := lessThan.Lhs().(*aladino.RelativeTimeConst)
+	assert.Equal(t, 3, relativeTime.Amount())
+	assert.Equal(t, "day", relativeTime.Unit())
+	assert.Equal(t, 1649116800, lessThan.Rhs().(*aladino.TimeConst).Value())
+
+	eq := and.Rhs().(*aladino.BinaryOp)
+	neg := eq.Lhs().(*aladino.UnaryOp)
+	assert.Equal(t, "-", neg.Operator())
+	assert.Equal(t, 1, neg.Operand().(*aladino.IntConst).Value())
+	assert.Equal(t, 0.5, eq.Rhs().(*aladino.FloatConst).Value())
+}
+
+func TestAccessors_DoNotExposeTheAST(t *testing.T) {
+	expr, err := aladino.Parse(`["a", "b"]`)
+	if err != nil {
+		assert.FailNow(t, "parse failed: %v", err)
+	}
+
+	array := expr.(*aladino.Array)
+	array.Elems()[0] = aladino.BuildStringConst("c")
+
+	assert.Equal(t, "a", array.Elems()[0].(*aladino.StringConst).Value())
+}
+
+func TestParseWithPositions(t *testing.T) {
+	expr, positions, err := aladino.ParseWithPositions("$size() > 10\n\t&& $isDraft()\n")
+	if err != nil {
+		assert.FailNow(t, "parse failed: %v", err)
+	}
+
+	isDraft := expr.(*aladino.BinaryOp).Rhs()
+
+	gotPosition, ok := positions.Of(isDraft)
+
+	assert.True(t, ok)
+	assert.Equal(t, aladino.Position{Offset: 17, Line: 2, Column: 5}, gotPosition)
+}
+
+func TestParseWithPositions_WhenExprIsNotParsed(t *testing.T) {
+	_, positions, err := aladino.ParseWithPositions("$size() > 10")
+	if err != nil {
+		assert.FailNow(t, "parse failed: %v", err)
+	}
+
+	_, ok := positions.Of(aladino.BuildIntConst(10))
+
+	assert.False(t, ok)
+}
+
+func TestParseFunctionDefWithPositions(t *testing.T) {
+	def, positions, err := aladino.ParseFunctionDefWithPositions("touches(path: String): Bool = $hasFilePattern($path)")
+	if err != nil {
+		assert.FailNow(t, "parse failed: %v", err)
+	}
+
+	assert.Equal(t, "touches", def.Name())
+	assert.Equal(t, aladino.BOOL_TYPE, def.ReturnType().Kind())
+
+	gotPosition, ok := positions.Of(def.Body())
+
+	assert.True(t, ok)
+	assert.Equal(t, aladino.Position{Offset: 30, Line: 1, Column: 31}, gotPosition)
+}
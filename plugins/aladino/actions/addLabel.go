@@ -5,7 +5,9 @@
 package plugins_aladino_actions
 
 import (
+	"fmt"
 	"log"
+	"strings"
 
 	"github.com/reviewpad/reviewpad/v3/lang/aladino"
 	"github.com/reviewpad/reviewpad/v3/utils"
@@ -13,30 +15,45 @@ import (
 
 func AddLabel() *aladino.BuiltInAction {
 	return &aladino.BuiltInAction{
-		Type: aladino.BuildFunctionType([]aladino.Type{aladino.BuildStringType()}, nil),
-		Code: addLabelCode,
+		Type:     aladino.BuildVariadicFunctionType([]aladino.Type{aladino.BuildStringType()}, nil),
+		Code:     addLabelCode,
+		Describe: addLabelDescribe,
 	}
 }
 
 func addLabelCode(e aladino.Env, args []aladino.Value) error {
-	labelID := args[0].(*aladino.StringValue).Val
-
 	prNum := utils.GetPullRequestNumber(e.GetPullRequest())
 	owner := utils.GetPullRequestBaseOwnerName(e.GetPullRequest())
 	repo := utils.GetPullRequestBaseRepoName(e.GetPullRequest())
 
-	internalLabelID := aladino.BuildInternalLabelID(labelID)
+	labelNames := make([]string, len(args))
+	for i, arg := range args {
+		labelID := arg.(*aladino.StringValue).Val
 
-	var labelName string
+		internalLabelID := aladino.BuildInternalLabelID(labelID)
 
-	if val, ok := e.GetRegisterMap()[internalLabelID]; ok {
-		labelName = val.(*aladino.StringValue).Val
-	} else {
-		labelName = labelID
-		log.Printf("[warn]: addLabel %v was not found in the environment", labelID)
+		if val, ok := e.GetRegisterMap()[internalLabelID]; ok {
+			labelNames[i] = val.(*aladino.StringValue).Val
+		} else {
+			labelNames[i] = labelID
+			log.Printf("[warn]: addLabel %v was not found in the environment", labelID)
+		}
 	}
 
-	_, _, err := e.GetClient().Issues.AddLabelsToIssue(e.GetCtx(), owner, repo, prNum, []string{labelName})
+	_, _, err := e.GetClient().Issues.AddLabelsToIssue(e.GetCtx(), owner, repo, prNum, labelNames)
 
 	return err
 }
+
+func addLabelDescribe(e aladino.Env, args []aladino.Value) string {
+	labelNames := make([]string, len(args))
+	for i, arg := range args {
+		labelNames[i] = labelName(e, arg.(*aladino.StringValue).Val)
+	}
+
+	if len(labelNames) == 1 {
+		return fmt.Sprintf("add label %v", labelNames[0])
+	}
+
+	return fmt.Sprintf("add labels %v", strings.Join(labelNames, ", "))
+}
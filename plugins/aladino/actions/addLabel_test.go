@@ -129,3 +129,38 @@ func TestAddLabel_WhenLabelIsNotInEnvironment(t *testing.T) {
 	assert.Nil(t, err)
 	assert.ElementsMatch(t, wantLabels, gotLabels)
 }
+
+func TestAddLabel_WhenManyLabels(t *testing.T) {
+	wantLabels := []string{"bug", "severity: critical", "needs-review"}
+	gotLabels := []string{}
+	mockedEnv, err := aladino.MockDefaultEnv(
+		[]mock.MockBackendOption{
+			mock.WithRequestMatchHandler(
+				mock.PostReposIssuesLabelsByOwnerByRepoByIssueNumber,
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					rawBody, _ := ioutil.ReadAll(r.Body)
+					body := []string{}
+
+					json.Unmarshal(rawBody, &body)
+
+					gotLabels = body
+				}),
+			),
+		},
+		nil,
+	)
+	if err != nil {
+		log.Fatalf("mockDefaultEnv failed: %v", err)
+	}
+	mockedEnv.GetRegisterMap()[aladino.BuildInternalLabelID("critical")] = aladino.BuildStringValue("severity: critical")
+
+	args := []aladino.Value{
+		aladino.BuildStringValue("bug"),
+		aladino.BuildStringValue("critical"),
+		aladino.BuildStringValue("needs-review"),
+	}
+	err = addLabel(mockedEnv, args)
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantLabels, gotLabels)
+}
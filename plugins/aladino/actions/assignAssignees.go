@@ -13,8 +13,9 @@ import (
 
 func AssignAssignees() *aladino.BuiltInAction {
 	return &aladino.BuiltInAction{
-		Type: aladino.BuildFunctionType([]aladino.Type{aladino.BuildArrayOfType(aladino.BuildStringType())}, nil),
-		Code: assignAssigneesCode,
+		Type:     aladino.BuildFunctionType([]aladino.Type{aladino.BuildArrayOfType(aladino.BuildStringType())}, nil),
+		Code:     assignAssigneesCode,
+		Describe: assignAssigneesDescribe,
 	}
 }
 
@@ -41,3 +42,7 @@ func assignAssigneesCode(e aladino.Env, args []aladino.Value) error {
 
 	return err
 }
+
+func assignAssigneesDescribe(e aladino.Env, args []aladino.Value) string {
+	return fmt.Sprintf("assign %v", joinStrings(args[0].(*aladino.ArrayValue).Vals))
+}
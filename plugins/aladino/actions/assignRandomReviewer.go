@@ -14,8 +14,9 @@ import (
 
 func AssignRandomReviewer() *aladino.BuiltInAction {
 	return &aladino.BuiltInAction{
-		Type: aladino.BuildFunctionType([]aladino.Type{}, nil),
-		Code: assignRandomReviewerCode,
+		Type:     aladino.BuildFunctionType([]aladino.Type{}, nil),
+		Code:     assignRandomReviewerCode,
+		Describe: assignRandomReviewerDescribe,
 	}
 }
 
@@ -61,3 +62,7 @@ func assignRandomReviewerCode(e aladino.Env, _ []aladino.Value) error {
 
 	return err
 }
+
+func assignRandomReviewerDescribe(e aladino.Env, _ []aladino.Value) string {
+	return "request review from a random collaborator"
+}
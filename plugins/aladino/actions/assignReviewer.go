@@ -15,8 +15,15 @@ import (
 
 func AssignReviewer() *aladino.BuiltInAction {
 	return &aladino.BuiltInAction{
-		Type: aladino.BuildFunctionType([]aladino.Type{aladino.BuildArrayOfType(aladino.BuildStringType()), aladino.BuildIntType()}, nil),
-		Code: assignReviewerCode,
+		Type: aladino.BuildFunctionTypeWithParams(
+			[]*aladino.Param{
+				aladino.BuildParam("reviewers", aladino.BuildArrayOfType(aladino.BuildStringType())),
+				aladino.BuildOptionalParam("total", aladino.BuildIntType(), aladino.BuildIntValue(99)),
+			},
+			nil,
+		),
+		Code:     assignReviewerCode,
+		Describe: assignReviewerDescribe,
 	}
 }
 
@@ -109,3 +116,14 @@ func assignReviewerCode(e aladino.Env, args []aladino.Value) error {
 
 	return err
 }
+
+func assignReviewerDescribe(e aladino.Env, args []aladino.Value) string {
+	totalRequiredReviewers := args[1].(*aladino.IntValue).Val
+	availableReviewers := args[0].(*aladino.ArrayValue).Vals
+
+	if totalRequiredReviewers >= len(availableReviewers) {
+		return fmt.Sprintf("request review from %v", joinStrings(availableReviewers))
+	}
+
+	return fmt.Sprintf("request review from %v of %v", totalRequiredReviewers, joinStrings(availableReviewers))
+}
@@ -14,8 +14,9 @@ import (
 
 func AssignTeamReviewer() *aladino.BuiltInAction {
 	return &aladino.BuiltInAction{
-		Type: aladino.BuildFunctionType([]aladino.Type{aladino.BuildArrayOfType(aladino.BuildStringType())}, nil),
-		Code: assignTeamReviewerCode,
+		Type:     aladino.BuildFunctionType([]aladino.Type{aladino.BuildArrayOfType(aladino.BuildStringType())}, nil),
+		Code:     assignTeamReviewerCode,
+		Describe: assignTeamReviewerDescribe,
 	}
 }
 
@@ -43,3 +44,7 @@ func assignTeamReviewerCode(e aladino.Env, args []aladino.Value) error {
 
 	return err
 }
+
+func assignTeamReviewerDescribe(e aladino.Env, args []aladino.Value) string {
+	return fmt.Sprintf("request review from teams %v", joinStrings(args[0].(*aladino.ArrayValue).Vals))
+}
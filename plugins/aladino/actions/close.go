@@ -11,8 +11,9 @@ import (
 
 func Close() *aladino.BuiltInAction {
 	return &aladino.BuiltInAction{
-		Type: aladino.BuildFunctionType([]aladino.Type{}, nil),
-		Code: closeCode,
+		Type:     aladino.BuildFunctionType([]aladino.Type{}, nil),
+		Code:     closeCode,
+		Describe: closeDescribe,
 	}
 }
 
@@ -29,3 +30,7 @@ func closeCode(e aladino.Env, args []aladino.Value) error {
 
 	return err
 }
+
+func closeDescribe(e aladino.Env, _ []aladino.Value) string {
+	return "close the pull request"
+}
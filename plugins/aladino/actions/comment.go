@@ -5,6 +5,8 @@
 package plugins_aladino_actions
 
 import (
+	"fmt"
+
 	"github.com/google/go-github/v42/github"
 	"github.com/reviewpad/reviewpad/v3/lang/aladino"
 	"github.com/reviewpad/reviewpad/v3/utils"
@@ -12,8 +14,9 @@ import (
 
 func Comment() *aladino.BuiltInAction {
 	return &aladino.BuiltInAction{
-		Type: aladino.BuildFunctionType([]aladino.Type{aladino.BuildStringType()}, nil),
-		Code: commentCode,
+		Type:     aladino.BuildFunctionType([]aladino.Type{aladino.BuildStringType()}, nil),
+		Code:     commentCode,
+		Describe: commentDescribe,
 	}
 }
 
@@ -32,3 +35,7 @@ func commentCode(e aladino.Env, args []aladino.Value) error {
 
 	return err
 }
+
+func commentDescribe(e aladino.Env, args []aladino.Value) string {
+	return fmt.Sprintf("comment %q", args[0].(*aladino.StringValue).Val)
+}
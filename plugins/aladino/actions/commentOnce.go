@@ -17,8 +17,9 @@ const ReviewpadCommentAnnotation = "<!--@annotation-reviewpad-single-comment-->"
 
 func CommentOnce() *aladino.BuiltInAction {
 	return &aladino.BuiltInAction{
-		Type: aladino.BuildFunctionType([]aladino.Type{aladino.BuildStringType()}, nil),
-		Code: commentOnceCode,
+		Type:     aladino.BuildFunctionType([]aladino.Type{aladino.BuildStringType()}, nil),
+		Code:     commentOnceCode,
+		Describe: commentOnceDescribe,
 	}
 }
 
@@ -52,3 +53,7 @@ func commentOnceCode(e aladino.Env, args []aladino.Value) error {
 
 	return err
 }
+
+func commentOnceDescribe(e aladino.Env, args []aladino.Value) string {
+	return fmt.Sprintf("comment %q unless it was already commented", args[0].(*aladino.StringValue).Val)
+}
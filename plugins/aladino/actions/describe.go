@@ -0,0 +1,32 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_actions
+
+import (
+	"strings"
+
+	"github.com/reviewpad/reviewpad/v3/lang/aladino"
+)
+
+// The descriptions of the actions are shown in the plan of a dry run (e.g. add label large).
+
+// joinStrings joins the values of an array of strings (e.g. alice, bob).
+func joinStrings(vals []aladino.Value) string {
+	strs := make([]string, len(vals))
+	for i, val := range vals {
+		strs[i] = val.(*aladino.StringValue).Val
+	}
+
+	return strings.Join(strs, ", ")
+}
+
+// labelName returns the name of the label declared with labelID in the reviewpad file (labelID when it is not declared).
+func labelName(e aladino.Env, labelID string) string {
+	if val, ok := e.GetRegisterMap()[aladino.BuildInternalLabelID(labelID)]; ok {
+		return val.(*aladino.StringValue).Val
+	}
+
+	return labelID
+}
@@ -0,0 +1,77 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_actions_test
+
+import (
+	"log"
+	"testing"
+
+	"github.com/reviewpad/reviewpad/v3/lang/aladino"
+	plugins_aladino "github.com/reviewpad/reviewpad/v3/plugins/aladino"
+	"github.com/stretchr/testify/assert"
+)
+
+func describe(action string, args ...aladino.Value) string {
+	mockedEnv, err := aladino.MockDefaultEnv(nil, nil)
+	if err != nil {
+		log.Fatalf("mockDefaultEnv failed: %v", err)
+	}
+
+	mockedEnv.GetRegisterMap()[aladino.BuildInternalLabelID("large")] = aladino.BuildStringValue("size/large")
+
+	return plugins_aladino.PluginBuiltIns().Actions[action].Describe(mockedEnv, args)
+}
+
+func buildStringsValue(strs ...string) *aladino.ArrayValue {
+	vals := make([]aladino.Value, len(strs))
+	for i, str := range strs {
+		vals[i] = aladino.BuildStringValue(str)
+	}
+
+	return aladino.BuildArrayValue(vals)
+}
+
+func TestDescribe_AddLabel(t *testing.T) {
+	assert.Equal(t, "add label size/large", describe("addLabel", aladino.BuildStringValue("large")))
+	assert.Equal(t, "add labels bug, size/large", describe("addLabel", aladino.BuildStringValue("bug"), aladino.BuildStringValue("large")))
+}
+
+func TestDescribe_RemoveLabel(t *testing.T) {
+	assert.Equal(t, "remove label size/large", describe("removeLabel", aladino.BuildStringValue("large")))
+}
+
+func TestDescribe_AssignAssignees(t *testing.T) {
+	assert.Equal(t, "assign alice, bob", describe("assignAssignees", buildStringsValue("alice", "bob")))
+}
+
+func TestDescribe_AssignRandomReviewer(t *testing.T) {
+	assert.Equal(t, "request review from a random collaborator", describe("assignRandomReviewer"))
+}
+
+func TestDescribe_AssignReviewer(t *testing.T) {
+	assert.Equal(t, "request review from alice", describe("assignReviewer", buildStringsValue("alice"), aladino.BuildIntValue(99)))
+	assert.Equal(t, "request review from 1 of alice, bob", describe("assignReviewer", buildStringsValue("alice", "bob"), aladino.BuildIntValue(1)))
+}
+
+func TestDescribe_AssignTeamReviewer(t *testing.T) {
+	assert.Equal(t, "request review from teams core", describe("assignTeamReviewer", buildStringsValue("core")))
+}
+
+func TestDescribe_Close(t *testing.T) {
+	assert.Equal(t, "close the pull request", describe("close"))
+}
+
+func TestDescribe_Comment(t *testing.T) {
+	assert.Equal(t, `comment "Thanks!"`, describe("comment", aladino.BuildStringValue("Thanks!")))
+	assert.Equal(t, `comment "Thanks!" unless it was already commented`, describe("commentOnce", aladino.BuildStringValue("Thanks!")))
+}
+
+func TestDescribe_Fail(t *testing.T) {
+	assert.Equal(t, `fail with "too large"`, describe("fail", aladino.BuildStringValue("too large")))
+}
+
+func TestDescribe_Merge(t *testing.T) {
+	assert.Equal(t, "merge with rebase", describe("merge", aladino.BuildStringValue("rebase")))
+}
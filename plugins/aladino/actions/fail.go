@@ -5,22 +5,39 @@
 package plugins_aladino_actions
 
 import (
-	"log"
+	"fmt"
 
 	"github.com/reviewpad/reviewpad/v3/lang/aladino"
 )
 
+// FailError is returned by $fail to stop the execution of the program with the given message.
+type FailError struct {
+	Message string
+}
+
+func (e *FailError) Error() string {
+	return e.Message
+}
+
+// StopsExecution makes $fail stop the program even when its errors are ignored (see aladino.StopError).
+func (e *FailError) StopsExecution() bool {
+	return true
+}
+
 func Fail() *aladino.BuiltInAction {
 	return &aladino.BuiltInAction{
-		Type: aladino.BuildFunctionType([]aladino.Type{aladino.BuildStringType()}, nil),
-		Code: failCode,
+		Type:     aladino.BuildFunctionType([]aladino.Type{aladino.BuildStringType()}, nil),
+		Code:     failCode,
+		Describe: failDescribe,
 	}
 }
 
 func failCode(e aladino.Env, args []aladino.Value) error {
 	failMessage := args[0].(*aladino.StringValue).Val
 
-	log.Fatalf(failMessage)
+	return &FailError{Message: failMessage}
+}
 
-	return nil
+func failDescribe(e aladino.Env, args []aladino.Value) string {
+	return fmt.Sprintf("fail with %q", args[0].(*aladino.StringValue).Val)
 }
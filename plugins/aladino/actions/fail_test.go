@@ -0,0 +1,39 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_actions_test
+
+import (
+	"log"
+	"testing"
+
+	"github.com/reviewpad/reviewpad/v3/lang/aladino"
+	plugins_aladino "github.com/reviewpad/reviewpad/v3/plugins/aladino"
+	plugins_aladino_actions "github.com/reviewpad/reviewpad/v3/plugins/aladino/actions"
+	"github.com/stretchr/testify/assert"
+)
+
+var fail = plugins_aladino.PluginBuiltIns().Actions["fail"].Code
+
+func TestFail(t *testing.T) {
+	mockedEnv, err := aladino.MockDefaultEnv(nil, nil)
+	if err != nil {
+		log.Fatalf("mockDefaultEnv failed: %v", err)
+	}
+
+	args := []aladino.Value{aladino.BuildStringValue("pull request is too large")}
+	err = fail(mockedEnv, args)
+
+	assert.Equal(t, &plugins_aladino_actions.FailError{Message: "pull request is too large"}, err)
+	assert.EqualError(t, err, "pull request is too large")
+}
+
+func TestFail_StopsExecution(t *testing.T) {
+	var err error = &plugins_aladino_actions.FailError{Message: "pull request is too large"}
+
+	stopErr, ok := err.(aladino.StopError)
+
+	assert.True(t, ok)
+	assert.True(t, stopErr.StopsExecution())
+}
@@ -14,8 +14,14 @@ import (
 
 func Merge() *aladino.BuiltInAction {
 	return &aladino.BuiltInAction{
-		Type: aladino.BuildFunctionType([]aladino.Type{aladino.BuildStringType()}, nil),
-		Code: mergeCode,
+		Type: aladino.BuildFunctionTypeWithParams(
+			[]*aladino.Param{
+				aladino.BuildOptionalParam("method", aladino.BuildStringType(), aladino.BuildStringValue("merge")),
+			},
+			nil,
+		),
+		Code:     mergeCode,
+		Describe: mergeDescribe,
 	}
 }
 
@@ -48,3 +54,7 @@ func parseMergeMethod(args []aladino.Value) (string, error) {
 		return "", fmt.Errorf("merge: unsupported merge method %v", mergeMethod)
 	}
 }
+
+func mergeDescribe(e aladino.Env, args []aladino.Value) string {
+	return fmt.Sprintf("merge with %v", args[0].(*aladino.StringValue).Val)
+}
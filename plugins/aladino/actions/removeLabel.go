@@ -5,6 +5,7 @@
 package plugins_aladino_actions
 
 import (
+	"fmt"
 	"log"
 
 	"github.com/reviewpad/reviewpad/v3/lang/aladino"
@@ -13,8 +14,9 @@ import (
 
 func RemoveLabel() *aladino.BuiltInAction {
 	return &aladino.BuiltInAction{
-		Type: aladino.BuildFunctionType([]aladino.Type{aladino.BuildStringType()}, nil),
-		Code: removeLabelCode,
+		Type:     aladino.BuildFunctionType([]aladino.Type{aladino.BuildStringType()}, nil),
+		Code:     removeLabelCode,
+		Describe: removeLabelDescribe,
 	}
 }
 
@@ -52,3 +54,7 @@ func removeLabelCode(e aladino.Env, args []aladino.Value) error {
 
 	return err
 }
+
+func removeLabelDescribe(e aladino.Env, args []aladino.Value) string {
+	return fmt.Sprintf("remove label %v", labelName(e, args[0].(*aladino.StringValue).Val))
+}
@@ -41,24 +41,38 @@ func PluginBuiltIns() *aladino.BuiltIns {
 			"reviewers":         functions.Reviewers(),
 			"size":              functions.Size(),
 			"title":             functions.Title(),
+			"updatedAt":         functions.UpdatedAt(),
 			"workflowStatus":    functions.WorkflowStatus(),
 			"reviewerStatus":    functions.ReviewerStatus(),
+			// Event
+			"event":       functions.Event(),
+			"eventObject": functions.EventObject(),
 			// Organization
 			"organization": functions.Organization(),
 			"team":         functions.Team(),
 			// User
 			"totalCreatedPullRequests": functions.TotalCreatedPullRequests(),
 			// Utilities
+			"now":         functions.Now(),
 			"append":      functions.AppendString(),
+			"concat":      functions.Concat(),
 			"contains":    functions.Contains(),
+			"get":         functions.Get(),
 			"isElementOf": functions.IsElementOf(),
 			"startsWith":  functions.StartsWith(),
 			"length":      functions.Length(),
+			// Numbers
+			"floor": functions.Floor(),
+			"round": functions.Round(),
+			// Higher-order
+			"all":    functions.All(),
+			"any":    functions.Any(),
+			"count":  functions.Count(),
+			"filter": functions.Filter(),
+			"map":    functions.Map(),
 			// Engine
 			"group": functions.Group(),
 			"rule":  functions.Rule(),
-			// Internal
-			"filter": functions.Filter(),
 		},
 		Actions: map[string]*aladino.BuiltInAction{
 			"addLabel":             actions.AddLabel(),
@@ -0,0 +1,56 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions_test
+
+import (
+	"log"
+	"testing"
+
+	"github.com/reviewpad/reviewpad/v3/lang/aladino"
+	plugins_aladino "github.com/reviewpad/reviewpad/v3/plugins/aladino"
+	"github.com/stretchr/testify/assert"
+)
+
+var allFn = plugins_aladino.PluginBuiltIns().Functions["all"].Code
+
+func TestAll_WhenEveryElementMatches(t *testing.T) {
+	mockedEnv, err := aladino.MockDefaultEnv(nil, nil)
+	if err != nil {
+		log.Fatalf("mockDefaultEnv failed: %v", err)
+	}
+
+	args := []aladino.Value{
+		aladino.BuildArrayValue([]aladino.Value{aladino.BuildStringValue("bot"), aladino.BuildStringValue("bot")}),
+		aladino.BuildFunctionValue(func(args []aladino.Value) (aladino.Value, error) {
+			return aladino.BuildBoolValue(args[0].(*aladino.StringValue).Val == "bot"), nil
+		}),
+	}
+	gotVal, err := allFn(mockedEnv, args)
+
+	wantVal := aladino.BuildTrueValue()
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestAll_WhenSomeElementDoesNotMatch(t *testing.T) {
+	mockedEnv, err := aladino.MockDefaultEnv(nil, nil)
+	if err != nil {
+		log.Fatalf("mockDefaultEnv failed: %v", err)
+	}
+
+	args := []aladino.Value{
+		aladino.BuildArrayValue([]aladino.Value{aladino.BuildStringValue("bot"), aladino.BuildStringValue("jane")}),
+		aladino.BuildFunctionValue(func(args []aladino.Value) (aladino.Value, error) {
+			return aladino.BuildBoolValue(args[0].(*aladino.StringValue).Val == "bot"), nil
+		}),
+	}
+	gotVal, err := allFn(mockedEnv, args)
+
+	wantVal := aladino.BuildFalseValue()
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantVal, gotVal)
+}
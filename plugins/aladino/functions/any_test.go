@@ -0,0 +1,56 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions_test
+
+import (
+	"log"
+	"testing"
+
+	"github.com/reviewpad/reviewpad/v3/lang/aladino"
+	plugins_aladino "github.com/reviewpad/reviewpad/v3/plugins/aladino"
+	"github.com/stretchr/testify/assert"
+)
+
+var anyFn = plugins_aladino.PluginBuiltIns().Functions["any"].Code
+
+func TestAny_WhenSomeElementMatches(t *testing.T) {
+	mockedEnv, err := aladino.MockDefaultEnv(nil, nil)
+	if err != nil {
+		log.Fatalf("mockDefaultEnv failed: %v", err)
+	}
+
+	args := []aladino.Value{
+		aladino.BuildArrayValue([]aladino.Value{aladino.BuildStringValue("john"), aladino.BuildStringValue("bot")}),
+		aladino.BuildFunctionValue(func(args []aladino.Value) (aladino.Value, error) {
+			return aladino.BuildBoolValue(args[0].(*aladino.StringValue).Val == "bot"), nil
+		}),
+	}
+	gotVal, err := anyFn(mockedEnv, args)
+
+	wantVal := aladino.BuildTrueValue()
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestAny_WhenNoElementMatches(t *testing.T) {
+	mockedEnv, err := aladino.MockDefaultEnv(nil, nil)
+	if err != nil {
+		log.Fatalf("mockDefaultEnv failed: %v", err)
+	}
+
+	args := []aladino.Value{
+		aladino.BuildArrayValue([]aladino.Value{aladino.BuildStringValue("john"), aladino.BuildStringValue("jane")}),
+		aladino.BuildFunctionValue(func(args []aladino.Value) (aladino.Value, error) {
+			return aladino.BuildBoolValue(args[0].(*aladino.StringValue).Val == "bot"), nil
+		}),
+	}
+	gotVal, err := anyFn(mockedEnv, args)
+
+	wantVal := aladino.BuildFalseValue()
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantVal, gotVal)
+}
@@ -8,14 +8,16 @@ import "github.com/reviewpad/reviewpad/v3/lang/aladino"
 
 func AppendString() *aladino.BuiltInFunction {
 	return &aladino.BuiltInFunction{
-		Type: aladino.BuildFunctionType([]aladino.Type{aladino.BuildArrayOfType(aladino.BuildStringType()), aladino.BuildArrayOfType(aladino.BuildStringType())}, aladino.BuildArrayOfType(aladino.BuildStringType())),
+		Type: aladino.BuildVariadicFunctionType([]aladino.Type{aladino.BuildArrayOfType(aladino.BuildStringType())}, aladino.BuildArrayOfType(aladino.BuildStringType())),
 		Code: appendStringCode,
 	}
 }
 
 func appendStringCode(e aladino.Env, args []aladino.Value) (aladino.Value, error) {
-	slice1 := args[0].(*aladino.ArrayValue).Vals
-	slice2 := args[1].(*aladino.ArrayValue).Vals
+	slice := make([]aladino.Value, 0)
+	for _, arg := range args {
+		slice = append(slice, arg.(*aladino.ArrayValue).Vals...)
+	}
 
-	return aladino.BuildArrayValue(append(slice1, slice2...)), nil
+	return aladino.BuildArrayValue(slice), nil
 }
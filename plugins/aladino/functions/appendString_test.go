@@ -47,3 +47,29 @@ func TestAppendString(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, wantSlice, gotSlice)
 }
+
+func TestAppendString_WhenManyArrays(t *testing.T) {
+	mockedEnv, err := aladino.MockDefaultEnv(nil, nil)
+	if err != nil {
+		log.Fatalf("mockDefaultEnv failed: %v", err)
+	}
+
+	args := []aladino.Value{
+		aladino.BuildArrayValue([]aladino.Value{aladino.BuildStringValue("a")}),
+		aladino.BuildArrayValue([]aladino.Value{}),
+		aladino.BuildArrayValue([]aladino.Value{aladino.BuildStringValue("b"), aladino.BuildStringValue("c")}),
+	}
+
+	wantSlice := aladino.BuildArrayValue(
+		[]aladino.Value{
+			aladino.BuildStringValue("a"),
+			aladino.BuildStringValue("b"),
+			aladino.BuildStringValue("c"),
+		},
+	)
+
+	gotSlice, err := appendString(mockedEnv, args)
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantSlice, gotSlice)
+}
@@ -14,6 +14,7 @@ func Comments() *aladino.BuiltInFunction {
 	return &aladino.BuiltInFunction{
 		Type: aladino.BuildFunctionType([]aladino.Type{}, aladino.BuildArrayOfType(aladino.BuildStringType())),
 		Code: commentsCode,
+		Pure: true,
 	}
 }
 
@@ -13,6 +13,7 @@ func Commits() *aladino.BuiltInFunction {
 	return &aladino.BuiltInFunction{
 		Type: aladino.BuildFunctionType([]aladino.Type{}, aladino.BuildArrayOfType(aladino.BuildStringType())),
 		Code: commitsCode,
+		Pure: true,
 	}
 }
 
@@ -0,0 +1,27 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions
+
+import (
+	"strings"
+
+	"github.com/reviewpad/reviewpad/v3/lang/aladino"
+)
+
+func Concat() *aladino.BuiltInFunction {
+	return &aladino.BuiltInFunction{
+		Type: aladino.BuildVariadicFunctionType([]aladino.Type{aladino.BuildStringType()}, aladino.BuildStringType()),
+		Code: concatCode,
+	}
+}
+
+func concatCode(e aladino.Env, args []aladino.Value) (aladino.Value, error) {
+	var str strings.Builder
+	for _, arg := range args {
+		str.WriteString(arg.(*aladino.StringValue).Val)
+	}
+
+	return aladino.BuildStringValue(str.String()), nil
+}
@@ -0,0 +1,75 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions_test
+
+import (
+	"log"
+	"testing"
+
+	"github.com/reviewpad/reviewpad/v3/lang/aladino"
+	plugins_aladino "github.com/reviewpad/reviewpad/v3/plugins/aladino"
+	"github.com/stretchr/testify/assert"
+)
+
+var countFn = plugins_aladino.PluginBuiltIns().Functions["count"].Code
+
+func TestCount(t *testing.T) {
+	mockedEnv, err := aladino.MockDefaultEnv(nil, nil)
+	if err != nil {
+		log.Fatalf("mockDefaultEnv failed: %v", err)
+	}
+
+	args := []aladino.Value{
+		aladino.BuildArrayValue([]aladino.Value{aladino.BuildStringValue("bot"), aladino.BuildStringValue("john"), aladino.BuildStringValue("bot")}),
+		aladino.BuildFunctionValue(func(args []aladino.Value) (aladino.Value, error) {
+			return aladino.BuildBoolValue(args[0].(*aladino.StringValue).Val == "bot"), nil
+		}),
+	}
+	gotVal, err := countFn(mockedEnv, args)
+
+	wantVal := aladino.BuildIntValue(2)
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestCount_WhenEmpty(t *testing.T) {
+	mockedEnv, err := aladino.MockDefaultEnv(nil, nil)
+	if err != nil {
+		log.Fatalf("mockDefaultEnv failed: %v", err)
+	}
+
+	args := []aladino.Value{
+		aladino.BuildArrayValue([]aladino.Value{}),
+		aladino.BuildFunctionValue(func(args []aladino.Value) (aladino.Value, error) {
+			return aladino.BuildBoolValue(args[0].(*aladino.StringValue).Val == "bot"), nil
+		}),
+	}
+	gotVal, err := countFn(mockedEnv, args)
+
+	wantVal := aladino.BuildIntValue(0)
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestCount_WhenLambda(t *testing.T) {
+	mockedEnv, err := aladino.MockDefaultEnvWithBuiltIns(nil, nil, plugins_aladino.PluginBuiltIns())
+	if err != nil {
+		log.Fatalf("mockDefaultEnvWithBuiltIns failed: %v", err)
+	}
+
+	expr, err := aladino.Parse(`$count(["bot-1", "john", "bot-2"], ($r: String => $startsWith($r, "bot")))`)
+	if err != nil {
+		log.Fatalf("parse failed: %v", err)
+	}
+
+	gotVal, err := expr.Eval(mockedEnv)
+
+	wantVal := aladino.BuildIntValue(2)
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantVal, gotVal)
+}
@@ -12,7 +12,7 @@ import (
 
 func CreatedAt() *aladino.BuiltInFunction {
 	return &aladino.BuiltInFunction{
-		Type: aladino.BuildFunctionType([]aladino.Type{}, aladino.BuildIntType()),
+		Type: aladino.BuildFunctionType([]aladino.Type{}, aladino.BuildTimeType()),
 		Code: createdAtCode,
 	}
 }
@@ -23,5 +23,5 @@ func createdAtCode(e aladino.Env, args []aladino.Value) (aladino.Value, error) {
 		return nil, err
 	}
 
-	return aladino.BuildIntValue(int(createdAtTime.Unix())), nil
+	return aladino.BuildTimeValue(int(createdAtTime.Unix())), nil
 }
@@ -43,7 +43,7 @@ func TestCreatedAt(t *testing.T) {
 	if err != nil {
 		log.Fatalf("time.Parse failed: %v", err)
 	}
-	wantCreatedAt := aladino.BuildIntValue(int(wantCreatedAtTime.Unix()))
+	wantCreatedAt := aladino.BuildTimeValue(int(wantCreatedAtTime.Unix()))
 
 	args := []aladino.Value{}
 	gotCreatedAt, err := createdAt(mockedEnv, args)
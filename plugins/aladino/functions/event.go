@@ -0,0 +1,95 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/reviewpad/reviewpad/v3/lang/aladino"
+	"github.com/reviewpad/reviewpad/v3/utils"
+)
+
+func Event() *aladino.BuiltInFunction {
+	return &aladino.BuiltInFunction{
+		Type: aladino.BuildFunctionTypeWithParams(
+			[]*aladino.Param{
+				aladino.BuildParam("path", aladino.BuildStringType()),
+				aladino.BuildOptionalParam("default", aladino.BuildJSONType(), aladino.BuildStringValue("")),
+			},
+			aladino.BuildJSONType(),
+		),
+		Code: eventCode,
+	}
+}
+
+// eventCode returns the field of the event payload at the path (e.g. "pull_request.number").
+// Fields that are not in the payload take the default value, which is an empty string so that rules on other events are false.
+func eventCode(e aladino.Env, args []aladino.Value) (aladino.Value, error) {
+	path := args[0].(*aladino.StringValue).Val
+
+	field, ok := utils.GetEventPayloadField(e.GetEventData(), path)
+	if !ok || field == nil {
+		return args[1], nil
+	}
+
+	return eventValue(field)
+}
+
+// eventValue converts a field of the decoded event payload into a value.
+// The null fields of objects and arrays are left out.
+func eventValue(field interface{}) (aladino.Value, error) {
+	switch field := field.(type) {
+	case string:
+		return aladino.BuildStringValue(field), nil
+	case bool:
+		return aladino.BuildBoolValue(field), nil
+	case json.Number:
+		if intValue, err := field.Int64(); err == nil {
+			return aladino.BuildIntValue(int(intValue)), nil
+		}
+
+		floatValue, err := field.Float64()
+		if err != nil {
+			return nil, err
+		}
+
+		return aladino.BuildFloatValue(floatValue), nil
+	case map[string]interface{}:
+		vals := make(map[string]aladino.Value, len(field))
+		for key, objectField := range field {
+			if objectField == nil {
+				continue
+			}
+
+			value, err := eventValue(objectField)
+			if err != nil {
+				return nil, err
+			}
+
+			vals[key] = value
+		}
+
+		return aladino.BuildMapValue(vals), nil
+	case []interface{}:
+		vals := make([]aladino.Value, 0, len(field))
+		for _, elem := range field {
+			if elem == nil {
+				continue
+			}
+
+			value, err := eventValue(elem)
+			if err != nil {
+				return nil, err
+			}
+
+			vals = append(vals, value)
+		}
+
+		return aladino.BuildArrayValue(vals), nil
+	}
+
+	return nil, fmt.Errorf("event: unsupported value %v", field)
+}
@@ -0,0 +1,36 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions
+
+import (
+	"fmt"
+
+	"github.com/reviewpad/reviewpad/v3/lang/aladino"
+	"github.com/reviewpad/reviewpad/v3/utils"
+)
+
+func EventObject() *aladino.BuiltInFunction {
+	return &aladino.BuiltInFunction{
+		Type: aladino.BuildFunctionType([]aladino.Type{aladino.BuildStringType()}, aladino.BuildMapType(aladino.BuildJSONType())),
+		Code: eventObjectCode,
+	}
+}
+
+// eventObjectCode returns the fields of the object of the event payload at the path (e.g. "pull_request.head").
+// The values of the fields are converted as in $event.
+func eventObjectCode(e aladino.Env, args []aladino.Value) (aladino.Value, error) {
+	path := args[0].(*aladino.StringValue).Val
+
+	field, ok := utils.GetEventPayloadField(e.GetEventData(), path)
+	if !ok || field == nil {
+		return aladino.BuildMapValue(map[string]aladino.Value{}), nil
+	}
+
+	if _, ok := field.(map[string]interface{}); !ok {
+		return nil, fmt.Errorf("eventObject: %v is not an object", path)
+	}
+
+	return eventValue(field)
+}
@@ -0,0 +1,77 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions_test
+
+import (
+	"log"
+	"testing"
+
+	"github.com/google/go-github/v42/github"
+	"github.com/reviewpad/reviewpad/v3/lang/aladino"
+	plugins_aladino "github.com/reviewpad/reviewpad/v3/plugins/aladino"
+	"github.com/stretchr/testify/assert"
+)
+
+var eventObject = plugins_aladino.PluginBuiltIns().Functions["eventObject"].Code
+
+func TestEventObject(t *testing.T) {
+	eventPayload := &github.PullRequestEvent{
+		PullRequest: &github.PullRequest{
+			Head: &github.PullRequestBranch{
+				Ref:  github.String("feature"),
+				User: &github.User{Login: github.String("john")},
+			},
+		},
+	}
+	mockedEnv, err := aladino.MockDefaultEnvWithEvent(nil, nil, eventPayload)
+	if err != nil {
+		log.Fatalf("mockDefaultEnvWithEvent failed: %v", err)
+	}
+
+	wantVal := aladino.BuildMapValue(map[string]aladino.Value{
+		"ref": aladino.BuildStringValue("feature"),
+		"user": aladino.BuildMapValue(map[string]aladino.Value{
+			"login": aladino.BuildStringValue("john"),
+		}),
+	})
+
+	args := []aladino.Value{aladino.BuildStringValue("pull_request.head")}
+	gotVal, err := eventObject(mockedEnv, args)
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestEventObject_WhenFieldDoesNotExist(t *testing.T) {
+	eventPayload := &github.PullRequestEvent{}
+	mockedEnv, err := aladino.MockDefaultEnvWithEvent(nil, nil, eventPayload)
+	if err != nil {
+		log.Fatalf("mockDefaultEnvWithEvent failed: %v", err)
+	}
+
+	wantVal := aladino.BuildMapValue(map[string]aladino.Value{})
+
+	args := []aladino.Value{aladino.BuildStringValue("review")}
+	gotVal, err := eventObject(mockedEnv, args)
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestEventObject_WhenFieldIsNotObject(t *testing.T) {
+	eventPayload := &github.PullRequestEvent{
+		Action: github.String("opened"),
+	}
+	mockedEnv, err := aladino.MockDefaultEnvWithEvent(nil, nil, eventPayload)
+	if err != nil {
+		log.Fatalf("mockDefaultEnvWithEvent failed: %v", err)
+	}
+
+	args := []aladino.Value{aladino.BuildStringValue("action")}
+	gotVal, err := eventObject(mockedEnv, args)
+
+	assert.Nil(t, gotVal)
+	assert.EqualError(t, err, "eventObject: action is not an object")
+}
@@ -0,0 +1,139 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions_test
+
+import (
+	"log"
+	"testing"
+
+	"github.com/google/go-github/v42/github"
+	"github.com/reviewpad/reviewpad/v3/lang/aladino"
+	plugins_aladino "github.com/reviewpad/reviewpad/v3/plugins/aladino"
+	"github.com/stretchr/testify/assert"
+)
+
+var event = plugins_aladino.PluginBuiltIns().Functions["event"].Code
+
+func TestEvent(t *testing.T) {
+	eventPayload := &github.PullRequestReviewEvent{
+		Review: &github.PullRequestReview{
+			State: github.String("approved"),
+		},
+	}
+	mockedEnv, err := aladino.MockDefaultEnvWithEvent(nil, nil, eventPayload)
+	if err != nil {
+		log.Fatalf("mockDefaultEnvWithEvent failed: %v", err)
+	}
+
+	wantVal := aladino.BuildStringValue("approved")
+
+	args := []aladino.Value{aladino.BuildStringValue("review.state"), aladino.BuildStringValue("")}
+	gotVal, err := event(mockedEnv, args)
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestEvent_WhenFieldIsNumber(t *testing.T) {
+	eventPayload := &github.PullRequestEvent{
+		Number: github.Int(42),
+	}
+	mockedEnv, err := aladino.MockDefaultEnvWithEvent(nil, nil, eventPayload)
+	if err != nil {
+		log.Fatalf("mockDefaultEnvWithEvent failed: %v", err)
+	}
+
+	wantVal := aladino.BuildIntValue(42)
+
+	args := []aladino.Value{aladino.BuildStringValue("number"), aladino.BuildStringValue("")}
+	gotVal, err := event(mockedEnv, args)
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestEvent_WhenFieldDoesNotExist(t *testing.T) {
+	eventPayload := &github.PullRequestEvent{
+		Action: github.String("opened"),
+	}
+	mockedEnv, err := aladino.MockDefaultEnvWithEvent(nil, nil, eventPayload)
+	if err != nil {
+		log.Fatalf("mockDefaultEnvWithEvent failed: %v", err)
+	}
+
+	wantVal := aladino.BuildStringValue("")
+
+	args := []aladino.Value{aladino.BuildStringValue("review.state"), aladino.BuildStringValue("")}
+	gotVal, err := event(mockedEnv, args)
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestEvent_WhenFieldDoesNotExistAndHasDefault(t *testing.T) {
+	eventPayload := &github.PullRequestEvent{
+		Action: github.String("opened"),
+	}
+	mockedEnv, err := aladino.MockDefaultEnvWithEvent(nil, nil, eventPayload)
+	if err != nil {
+		log.Fatalf("mockDefaultEnvWithEvent failed: %v", err)
+	}
+
+	wantVal := aladino.BuildIntValue(0)
+
+	args := []aladino.Value{aladino.BuildStringValue("number"), aladino.BuildIntValue(0)}
+	gotVal, err := event(mockedEnv, args)
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestEvent_WhenFieldIsObject(t *testing.T) {
+	eventPayload := &github.PullRequestEvent{
+		PullRequest: &github.PullRequest{
+			Draft: github.Bool(false),
+			Labels: []*github.Label{
+				{Name: github.String("bug")},
+			},
+		},
+	}
+	mockedEnv, err := aladino.MockDefaultEnvWithEvent(nil, nil, eventPayload)
+	if err != nil {
+		log.Fatalf("mockDefaultEnvWithEvent failed: %v", err)
+	}
+
+	wantVal := aladino.BuildMapValue(map[string]aladino.Value{
+		"draft": aladino.BuildBoolValue(false),
+		"labels": aladino.BuildArrayValue([]aladino.Value{
+			aladino.BuildMapValue(map[string]aladino.Value{"name": aladino.BuildStringValue("bug")}),
+		}),
+	})
+
+	args := []aladino.Value{aladino.BuildStringValue("pull_request"), aladino.BuildStringValue("")}
+	gotVal, err := event(mockedEnv, args)
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestEvent_WhenFieldIsOnlyInRawPayload(t *testing.T) {
+	eventPayload := &aladino.RawEventPayload{
+		Payload: &github.PullRequestEvent{Action: github.String("opened")},
+		JSON:    []byte(`{"action": "opened", "pull_request": {"auto_merge_ratio": 0.5}}`),
+	}
+	mockedEnv, err := aladino.MockDefaultEnvWithEvent(nil, nil, eventPayload)
+	if err != nil {
+		log.Fatalf("mockDefaultEnvWithEvent failed: %v", err)
+	}
+
+	wantVal := aladino.BuildFloatValue(0.5)
+
+	args := []aladino.Value{aladino.BuildStringValue("pull_request.auto_merge_ratio"), aladino.BuildStringValue("")}
+	gotVal, err := event(mockedEnv, args)
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantVal, gotVal)
+	assert.Equal(t, eventPayload.Payload, mockedEnv.GetEventPayload())
+}
@@ -10,13 +10,13 @@ func Filter() *aladino.BuiltInFunction {
 	return &aladino.BuiltInFunction{
 		Type: aladino.BuildFunctionType(
 			[]aladino.Type{
-				aladino.BuildArrayOfType(aladino.BuildStringType()),
+				aladino.BuildArrayOfType(aladino.BuildTypeVar("a")),
 				aladino.BuildFunctionType(
-					[]aladino.Type{aladino.BuildStringType()},
+					[]aladino.Type{aladino.BuildTypeVar("a")},
 					aladino.BuildBoolType(),
 				),
 			},
-			aladino.BuildArrayOfType(aladino.BuildStringType()),
+			aladino.BuildArrayOfType(aladino.BuildTypeVar("a")),
 		),
 		Code: filterCode,
 	}
@@ -28,8 +28,12 @@ func filterCode(e aladino.Env, args []aladino.Value) (aladino.Value, error) {
 	fn := args[1].(*aladino.FunctionValue).Fn
 
 	for _, elem := range elems {
-		fnResult := fn([]aladino.Value{elem}).(*aladino.BoolValue).Val
-		if fnResult {
+		fnResult, err := fn([]aladino.Value{elem})
+		if err != nil {
+			return nil, err
+		}
+
+		if fnResult.(*aladino.BoolValue).Val {
 			result = append(result, elem)
 		}
 	}
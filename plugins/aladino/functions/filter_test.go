@@ -24,8 +24,8 @@ func TestFilter(t *testing.T) {
 
 	args := []aladino.Value{
 		aladino.BuildArrayValue([]aladino.Value{aladino.BuildStringValue("1"), mockedIntValue}),
-		aladino.BuildFunctionValue(func(args []aladino.Value) aladino.Value {
-			return aladino.BuildBoolValue(args[0].HasKindOf(aladino.INT_VALUE))
+		aladino.BuildFunctionValue(func(args []aladino.Value) (aladino.Value, error) {
+			return aladino.BuildBoolValue(args[0].HasKindOf(aladino.INT_VALUE)), nil
 		}),
 	}
 	gotElems, err := filter(mockedEnv, args)
@@ -35,3 +35,21 @@ func TestFilter(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, wantElems, gotElems)
 }
+
+func TestFilter_WhenFunctionFails(t *testing.T) {
+	mockedEnv, err := aladino.MockDefaultEnv(nil, nil)
+	if err != nil {
+		log.Fatalf("mockDefaultEnv failed: %v", err)
+	}
+
+	args := []aladino.Value{
+		aladino.BuildArrayValue([]aladino.Value{aladino.BuildStringValue("1")}),
+		aladino.BuildFunctionValue(func(args []aladino.Value) (aladino.Value, error) {
+			return nil, assert.AnError
+		}),
+	}
+	gotElems, err := filter(mockedEnv, args)
+
+	assert.Nil(t, gotElems)
+	assert.Equal(t, assert.AnError, err)
+}
@@ -0,0 +1,25 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions
+
+import (
+	"math"
+
+	"github.com/reviewpad/reviewpad/v3/lang/aladino"
+)
+
+// Floor returns the greatest integer less than or equal to a number (e.g. $floor($size() / 100)).
+func Floor() *aladino.BuiltInFunction {
+	return &aladino.BuiltInFunction{
+		Type: aladino.BuildFunctionType([]aladino.Type{aladino.BuildFloatType()}, aladino.BuildIntType()),
+		Code: floorCode,
+	}
+}
+
+func floorCode(e aladino.Env, args []aladino.Value) (aladino.Value, error) {
+	number := args[0].(*aladino.FloatValue).Val
+
+	return aladino.BuildIntValue(int(math.Floor(number))), nil
+}
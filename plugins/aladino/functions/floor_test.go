@@ -0,0 +1,51 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions_test
+
+import (
+	"log"
+	"testing"
+
+	"github.com/reviewpad/reviewpad/v3/lang/aladino"
+	plugins_aladino "github.com/reviewpad/reviewpad/v3/plugins/aladino"
+	"github.com/stretchr/testify/assert"
+)
+
+var floor = plugins_aladino.PluginBuiltIns().Functions["floor"].Code
+
+func TestFloor(t *testing.T) {
+	mockedEnv, err := aladino.MockDefaultEnv(nil, nil)
+	if err != nil {
+		log.Fatalf("mockDefaultEnv failed: %v", err)
+	}
+
+	args := []aladino.Value{aladino.BuildFloatValue(3.7)}
+	gotVal, err := floor(mockedEnv, args)
+
+	assert.Nil(t, err)
+	assert.Equal(t, aladino.BuildIntValue(3), gotVal)
+}
+
+func TestFloor_WhenNegative(t *testing.T) {
+	mockedEnv, err := aladino.MockDefaultEnv(nil, nil)
+	if err != nil {
+		log.Fatalf("mockDefaultEnv failed: %v", err)
+	}
+
+	args := []aladino.Value{aladino.BuildFloatValue(-3.2)}
+	gotVal, err := floor(mockedEnv, args)
+
+	assert.Nil(t, err)
+	assert.Equal(t, aladino.BuildIntValue(-4), gotVal)
+}
+
+// The division is a float division, so its result needs $floor to be passed where an Int is expected
+func TestFloor_WhenDivisionIsPassedAsInt(t *testing.T) {
+	typeChecker := aladino.NewTypeChecker(plugins_aladino.PluginBuiltIns())
+
+	err := typeChecker.TypeCheckAction("test", `$assignReviewer(["john"], $floor($size() / 100))`, 0)
+
+	assert.Nil(t, err)
+}
@@ -0,0 +1,36 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions
+
+import (
+	"fmt"
+
+	"github.com/reviewpad/reviewpad/v3/lang/aladino"
+)
+
+func Get() *aladino.BuiltInFunction {
+	return &aladino.BuiltInFunction{
+		Type: aladino.BuildFunctionType(
+			[]aladino.Type{
+				aladino.BuildMapType(aladino.BuildTypeVar("a")),
+				aladino.BuildStringType(),
+			},
+			aladino.BuildTypeVar("a"),
+		),
+		Code: getCode,
+	}
+}
+
+func getCode(e aladino.Env, args []aladino.Value) (aladino.Value, error) {
+	vals := args[0].(*aladino.MapValue).Vals
+	key := args[1].(*aladino.StringValue).Val
+
+	value, ok := vals[key]
+	if !ok {
+		return nil, fmt.Errorf("get: key %v not found", key)
+	}
+
+	return value, nil
+}
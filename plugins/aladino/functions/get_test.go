@@ -0,0 +1,67 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions_test
+
+import (
+	"log"
+	"testing"
+
+	"github.com/reviewpad/reviewpad/v3/lang/aladino"
+	plugins_aladino "github.com/reviewpad/reviewpad/v3/plugins/aladino"
+	"github.com/stretchr/testify/assert"
+)
+
+var get = plugins_aladino.PluginBuiltIns().Functions["get"].Code
+
+func TestGet(t *testing.T) {
+	mockedEnv, err := aladino.MockDefaultEnv(nil, nil)
+	if err != nil {
+		log.Fatalf("mockDefaultEnv failed: %v", err)
+	}
+
+	wantVal := aladino.BuildStringValue("feature")
+
+	args := []aladino.Value{
+		aladino.BuildMapValue(map[string]aladino.Value{"ref": aladino.BuildStringValue("feature")}),
+		aladino.BuildStringValue("ref"),
+	}
+	gotVal, err := get(mockedEnv, args)
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantVal, gotVal)
+}
+
+func TestGet_WhenKeyDoesNotExist(t *testing.T) {
+	mockedEnv, err := aladino.MockDefaultEnv(nil, nil)
+	if err != nil {
+		log.Fatalf("mockDefaultEnv failed: %v", err)
+	}
+
+	args := []aladino.Value{
+		aladino.BuildMapValue(map[string]aladino.Value{}),
+		aladino.BuildStringValue("ref"),
+	}
+	gotVal, err := get(mockedEnv, args)
+
+	assert.Nil(t, gotVal)
+	assert.EqualError(t, err, "get: key ref not found")
+}
+
+func TestGet_WhenMapHasEventValues(t *testing.T) {
+	mockedEnv, err := aladino.MockDefaultEnvWithBuiltIns(nil, nil, plugins_aladino.PluginBuiltIns())
+	if err != nil {
+		log.Fatalf("mockDefaultEnvWithBuiltIns failed: %v", err)
+	}
+
+	expr, err := aladino.Parse(`$get($eventObject("pull_request"), "additions") > 2`)
+	if err != nil {
+		log.Fatalf("parse failed: %v", err)
+	}
+
+	gotType, err := aladino.TypeInference(mockedEnv, expr)
+
+	assert.Nil(t, err)
+	assert.Equal(t, aladino.BuildBoolType(), gotType)
+}
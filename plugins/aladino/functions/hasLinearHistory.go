@@ -14,6 +14,7 @@ func HasLinearHistory() *aladino.BuiltInFunction {
 	return &aladino.BuiltInFunction{
 		Type: aladino.BuildFunctionType([]aladino.Type{}, aladino.BuildBoolType()),
 		Code: hasLinearHistoryCode,
+		Pure: true,
 	}
 }
 
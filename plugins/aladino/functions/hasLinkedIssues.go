@@ -14,6 +14,7 @@ func HasLinkedIssues() *aladino.BuiltInFunction {
 	return &aladino.BuiltInFunction{
 		Type: aladino.BuildFunctionType([]aladino.Type{}, aladino.BuildBoolType()),
 		Code: hasLinkedIssuesCode,
+		Pure: true,
 	}
 }
 
@@ -8,13 +8,13 @@ import "github.com/reviewpad/reviewpad/v3/lang/aladino"
 
 func IsElementOf() *aladino.BuiltInFunction {
 	return &aladino.BuiltInFunction{
-		Type: aladino.BuildFunctionType([]aladino.Type{aladino.BuildStringType(), aladino.BuildArrayOfType(aladino.BuildStringType())}, aladino.BuildBoolType()),
+		Type: aladino.BuildFunctionType([]aladino.Type{aladino.BuildTypeVar("a"), aladino.BuildArrayOfType(aladino.BuildTypeVar("a"))}, aladino.BuildBoolType()),
 		Code: isElementOfCode,
 	}
 }
 
 func isElementOfCode(e aladino.Env, args []aladino.Value) (aladino.Value, error) {
-	member := args[0].(*aladino.StringValue)
+	member := args[0]
 	group := args[1].(*aladino.ArrayValue).Vals
 
 	for _, groupMember := range group {
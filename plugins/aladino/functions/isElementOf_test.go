@@ -56,3 +56,24 @@ func TestIsElementOf_WhenFalse(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, wantVal, gotVal)
 }
+
+func TestIsElementOf_WhenInts(t *testing.T) {
+	mockedEnv, err := aladino.MockDefaultEnv(nil, nil)
+	if err != nil {
+		log.Fatalf("mockDefaultEnv failed: %v", err)
+	}
+
+	args := []aladino.Value{
+		aladino.BuildIntValue(2),
+		aladino.BuildArrayValue([]aladino.Value{
+			aladino.BuildIntValue(1),
+			aladino.BuildIntValue(2),
+		}),
+	}
+	gotVal, err := isElementOf(mockedEnv, args)
+
+	wantVal := aladino.BuildBoolValue(true)
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantVal, gotVal)
+}
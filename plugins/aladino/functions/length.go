@@ -10,7 +10,7 @@ import (
 
 func Length() *aladino.BuiltInFunction {
 	return &aladino.BuiltInFunction{
-		Type: aladino.BuildFunctionType([]aladino.Type{aladino.BuildArrayOfType(aladino.BuildStringType())}, aladino.BuildIntType()),
+		Type: aladino.BuildFunctionType([]aladino.Type{aladino.BuildArrayOfType(aladino.BuildTypeVar("a"))}, aladino.BuildIntType()),
 		Code: lengthCode,
 	}
 }
@@ -36,3 +36,26 @@ func TestLength(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, wantLength, gotLength)
 }
+
+func TestLength_WhenArrayOfBools(t *testing.T) {
+	mockedEnv, err := aladino.MockDefaultEnv(nil, nil)
+	if err != nil {
+		log.Fatalf("mockDefaultEnv failed: %v", err)
+	}
+
+	slice := aladino.BuildArrayValue(
+		[]aladino.Value{
+			aladino.BuildBoolValue(true),
+			aladino.BuildBoolValue(false),
+			aladino.BuildBoolValue(true),
+		},
+	)
+	args := []aladino.Value{slice}
+
+	wantLength := &aladino.IntValue{Val: 3}
+
+	gotLength, err := length(mockedEnv, args)
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantLength, gotLength)
+}
@@ -0,0 +1,36 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions_test
+
+import (
+	"log"
+	"testing"
+
+	"github.com/reviewpad/reviewpad/v3/lang/aladino"
+	plugins_aladino "github.com/reviewpad/reviewpad/v3/plugins/aladino"
+	"github.com/stretchr/testify/assert"
+)
+
+var mapFn = plugins_aladino.PluginBuiltIns().Functions["map"].Code
+
+func TestMap(t *testing.T) {
+	mockedEnv, err := aladino.MockDefaultEnv(nil, nil)
+	if err != nil {
+		log.Fatalf("mockDefaultEnv failed: %v", err)
+	}
+
+	args := []aladino.Value{
+		aladino.BuildArrayValue([]aladino.Value{aladino.BuildStringValue("john"), aladino.BuildStringValue("jane")}),
+		aladino.BuildFunctionValue(func(args []aladino.Value) (aladino.Value, error) {
+			return aladino.BuildStringValue("@" + args[0].(*aladino.StringValue).Val), nil
+		}),
+	}
+	gotElems, err := mapFn(mockedEnv, args)
+
+	wantElems := aladino.BuildArrayValue([]aladino.Value{aladino.BuildStringValue("@john"), aladino.BuildStringValue("@jane")})
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantElems, gotElems)
+}
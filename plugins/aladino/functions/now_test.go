@@ -0,0 +1,31 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions_test
+
+import (
+	"log"
+	"testing"
+
+	"github.com/reviewpad/reviewpad/v3/lang/aladino"
+	plugins_aladino "github.com/reviewpad/reviewpad/v3/plugins/aladino"
+	"github.com/stretchr/testify/assert"
+)
+
+var now = plugins_aladino.PluginBuiltIns().Functions["now"].Code
+
+func TestNow(t *testing.T) {
+	mockedEnv, err := aladino.MockDefaultEnv(nil, nil)
+	if err != nil {
+		log.Fatalf("mockDefaultEnv failed: %v", err)
+	}
+
+	wantNow := aladino.BuildTimeValue(int(aladino.DefaultMockNow.Unix()))
+
+	args := []aladino.Value{}
+	gotNow, err := now(mockedEnv, args)
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantNow, gotNow)
+}
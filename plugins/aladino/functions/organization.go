@@ -10,6 +10,7 @@ func Organization() *aladino.BuiltInFunction {
 	return &aladino.BuiltInFunction{
 		Type: aladino.BuildFunctionType([]aladino.Type{}, aladino.BuildArrayOfType(aladino.BuildStringType())),
 		Code: organizationCode,
+		Pure: true,
 	}
 }
 
@@ -13,6 +13,7 @@ func ReviewerStatus() *aladino.BuiltInFunction {
 	return &aladino.BuiltInFunction{
 		Type: aladino.BuildFunctionType([]aladino.Type{aladino.BuildStringType()}, aladino.BuildStringType()),
 		Code: reviewerStatusCode,
+		Pure: true,
 	}
 }
 
@@ -0,0 +1,25 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions
+
+import (
+	"math"
+
+	"github.com/reviewpad/reviewpad/v3/lang/aladino"
+)
+
+// Round returns the nearest integer to a number, rounding half away from zero (e.g. $round($deletions() / 10)).
+func Round() *aladino.BuiltInFunction {
+	return &aladino.BuiltInFunction{
+		Type: aladino.BuildFunctionType([]aladino.Type{aladino.BuildFloatType()}, aladino.BuildIntType()),
+		Code: roundCode,
+	}
+}
+
+func roundCode(e aladino.Env, args []aladino.Value) (aladino.Value, error) {
+	number := args[0].(*aladino.FloatValue).Val
+
+	return aladino.BuildIntValue(int(math.Round(number))), nil
+}
@@ -13,6 +13,7 @@ func Team() *aladino.BuiltInFunction {
 	return &aladino.BuiltInFunction{
 		Type: aladino.BuildFunctionType([]aladino.Type{aladino.BuildStringType()}, aladino.BuildArrayOfType(aladino.BuildStringType())),
 		Code: teamCode,
+		Pure: true,
 	}
 }
 
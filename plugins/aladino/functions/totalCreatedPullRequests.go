@@ -14,6 +14,7 @@ func TotalCreatedPullRequests() *aladino.BuiltInFunction {
 	return &aladino.BuiltInFunction{
 		Type: aladino.BuildFunctionType([]aladino.Type{aladino.BuildStringType()}, aladino.BuildIntType()),
 		Code: totalCreatedPullRequestsCode,
+		Pure: true,
 	}
 }
 
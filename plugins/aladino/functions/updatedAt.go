@@ -0,0 +1,27 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions
+
+import (
+	"time"
+
+	"github.com/reviewpad/reviewpad/v3/lang/aladino"
+)
+
+func UpdatedAt() *aladino.BuiltInFunction {
+	return &aladino.BuiltInFunction{
+		Type: aladino.BuildFunctionType([]aladino.Type{}, aladino.BuildTimeType()),
+		Code: updatedAtCode,
+	}
+}
+
+func updatedAtCode(e aladino.Env, args []aladino.Value) (aladino.Value, error) {
+	updatedAtTime, err := time.Parse("2006-01-02 15:04:05.999999999 -0700 MST", e.GetPullRequest().GetUpdatedAt().String())
+	if err != nil {
+		return nil, err
+	}
+
+	return aladino.BuildTimeValue(int(updatedAtTime.Unix())), nil
+}
@@ -0,0 +1,53 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package plugins_aladino_functions_test
+
+import (
+	"log"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v42/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/reviewpad/reviewpad/v3/lang/aladino"
+	plugins_aladino "github.com/reviewpad/reviewpad/v3/plugins/aladino"
+	"github.com/stretchr/testify/assert"
+)
+
+var updatedAt = plugins_aladino.PluginBuiltIns().Functions["updatedAt"].Code
+
+func TestUpdatedAt(t *testing.T) {
+	date := time.Date(2022, 4, 5, 10, 0, 0, 0, time.UTC)
+	mockedPullRequest := aladino.GetDefaultMockPullRequestDetailsWith(&github.PullRequest{
+		UpdatedAt: &date,
+	})
+	mockedEnv, err := aladino.MockDefaultEnv(
+		[]mock.MockBackendOption{
+			mock.WithRequestMatchHandler(
+				mock.GetReposPullsByOwnerByRepoByPullNumber,
+				http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+					w.Write(mock.MustMarshal(mockedPullRequest))
+				}),
+			),
+		},
+		nil,
+	)
+	if err != nil {
+		log.Fatalf("mockDefaultEnv failed: %v", err)
+	}
+
+	wantUpdatedAtTime, err := time.Parse("2006-01-02 15:04:05.999999999 -0700 MST", date.String())
+	if err != nil {
+		log.Fatalf("time.Parse failed: %v", err)
+	}
+	wantUpdatedAt := aladino.BuildTimeValue(int(wantUpdatedAtTime.Unix()))
+
+	args := []aladino.Value{}
+	gotUpdatedAt, err := updatedAt(mockedEnv, args)
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantUpdatedAt, gotUpdatedAt)
+}
@@ -17,6 +17,7 @@ func WorkflowStatus() *aladino.BuiltInFunction {
 	return &aladino.BuiltInFunction{
 		Type: aladino.BuildFunctionType([]aladino.Type{aladino.BuildStringType()}, aladino.BuildStringType()),
 		Code: workflowStatusCode,
+		Pure: true,
 	}
 }
 
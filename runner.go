@@ -26,7 +26,7 @@ func Load(buf *bytes.Buffer) (*engine.ReviewpadFile, error) {
 
 	log.Println(fmtio.Sprintf("load", "input file:\n%+v\n", file))
 
-	err = engine.Lint(file)
+	err = engine.Lint(file, aladino.NewTypeChecker(plugins_aladino.PluginBuiltIns()))
 	if err != nil {
 		return nil, err
 	}
@@ -34,7 +34,14 @@ func Load(buf *bytes.Buffer) (*engine.ReviewpadFile, error) {
 	return file, nil
 }
 
-func Run(
+// Format rewrites the specs and actions of a reviewpad file in their canonical form.
+// The warnings are the specs kept as written (see engine.Format).
+func Format(data []byte) ([]byte, []*engine.FormatWarning, error) {
+	return engine.Format(data, aladino.NewFormatter())
+}
+
+// evaluate builds the interpreter and the program of the reviewpad file for the pull request.
+func evaluate(
 	ctx context.Context,
 	client *github.Client,
 	clientGQL *githubv4.Client,
@@ -43,27 +50,99 @@ func Run(
 	eventPayload interface{},
 	reviewpadFile *engine.ReviewpadFile,
 	dryRun bool,
-) (*engine.Program, error) {
-	aladinoInterpreter, err := aladino.NewInterpreter(ctx, client, clientGQL, collector, pullRequest, eventPayload, plugins_aladino.PluginBuiltIns())
+	clock aladino.Clock,
+) (engine.Interpreter, *engine.Env, *engine.Program, error) {
+	aladinoInterpreter, err := aladino.NewInterpreter(ctx, client, clientGQL, collector, pullRequest, eventPayload, plugins_aladino.PluginBuiltIns(), clock)
 	if err != nil {
-		return nil, err
+		return nil, nil, nil, err
+	}
+
+	// The rules are only explained in the report, which is neither built on dry runs nor in silent mode
+	if !dryRun && reviewpadFile.Mode != engine.SILENT_MODE {
+		aladinoInterpreter.(*aladino.Interpreter).Env.GetReport().Explain = true
 	}
 
 	evalEnv, err := engine.NewEvalEnv(ctx, dryRun, client, clientGQL, collector, pullRequest, eventPayload, aladinoInterpreter)
 	if err != nil {
-		return nil, err
+		return nil, nil, nil, err
 	}
 
 	program, err := engine.Eval(reviewpadFile, evalEnv)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return aladinoInterpreter, evalEnv, program, nil
+}
+
+// Plan returns what running the reviewpad file would do to the pull request without changing it.
+// When clock is nil, relative timestamps are computed from the current time of the system.
+func Plan(
+	ctx context.Context,
+	client *github.Client,
+	clientGQL *githubv4.Client,
+	collector collector.Collector,
+	pullRequest *github.PullRequest,
+	eventPayload interface{},
+	reviewpadFile *engine.ReviewpadFile,
+	clock aladino.Clock,
+) (*engine.Plan, error) {
+	aladinoInterpreter, evalEnv, program, err := evaluate(ctx, client, clientGQL, collector, pullRequest, eventPayload, reviewpadFile, true, clock)
 	if err != nil {
 		return nil, err
 	}
 
-	if !dryRun {
-		err := aladinoInterpreter.ExecProgram(program)
-		if err != nil {
-			engine.CollectError(evalEnv, err)
-			return nil, err
+	plan := engine.BuildPlan(program, aladinoInterpreter)
+
+	collectCompletedAnalysis(evalEnv)
+
+	return plan, nil
+}
+
+func Run(
+	ctx context.Context,
+	client *github.Client,
+	clientGQL *githubv4.Client,
+	collector collector.Collector,
+	pullRequest *github.PullRequest,
+	eventPayload interface{},
+	reviewpadFile *engine.ReviewpadFile,
+	dryRun bool,
+) (*engine.Program, error) {
+	return RunWithClock(ctx, client, clientGQL, collector, pullRequest, eventPayload, reviewpadFile, dryRun, aladino.NewSystemClock())
+}
+
+// RunWithClock is Run with the clock that tells the current time to relative timestamps (e.g. 3 days ago) and to $now().
+// When clock is nil, it is the clock of the system.
+func RunWithClock(
+	ctx context.Context,
+	client *github.Client,
+	clientGQL *githubv4.Client,
+	collector collector.Collector,
+	pullRequest *github.PullRequest,
+	eventPayload interface{},
+	reviewpadFile *engine.ReviewpadFile,
+	dryRun bool,
+	clock aladino.Clock,
+) (*engine.Program, error) {
+	aladinoInterpreter, evalEnv, program, err := evaluate(ctx, client, clientGQL, collector, pullRequest, eventPayload, reviewpadFile, dryRun, clock)
+	if err != nil {
+		return nil, err
+	}
+
+	if dryRun {
+		plan := engine.BuildPlan(program, aladinoInterpreter)
+
+		log.Println(fmtio.Sprintf("plan", "dry run:\n%v", plan.Text()))
+	} else {
+		execErr := aladinoInterpreter.ExecProgram(program)
+		if execErr != nil {
+			engine.CollectError(evalEnv, execErr)
+
+			// When the errors are ignored, the failed statements are listed in the report before the run fails
+			if !program.IgnoreErrors {
+				return nil, execErr
+			}
 		}
 
 		err = aladinoInterpreter.Report(reviewpadFile.Mode)
@@ -71,15 +150,24 @@ func Run(
 			engine.CollectError(evalEnv, err)
 			return nil, err
 		}
+
+		if execErr != nil {
+			return nil, execErr
+		}
 	}
 
-	err = evalEnv.Collector.Collect("Completed Analysis", map[string]interface{}{
+	collectCompletedAnalysis(evalEnv)
+
+	return program, nil
+}
+
+// collectCompletedAnalysis sends the event of a run or a dry run that went through the whole program.
+func collectCompletedAnalysis(evalEnv *engine.Env) {
+	err := evalEnv.Collector.Collect("Completed Analysis", map[string]interface{}{
 		"pullRequestUrl": evalEnv.PullRequest.URL,
 	})
 
 	if err != nil {
 		log.Printf("error on collector due to %v", err.Error())
 	}
-
-	return program, nil
 }
@@ -0,0 +1,58 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"strings"
+)
+
+// DecodeEventPayload decodes the JSON of an event payload, as sent in the webhook by GitHub.
+func DecodeEventPayload(rawPayload []byte) (interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(rawPayload))
+	// Keep numbers such as ids exactly as they are in the payload
+	decoder.UseNumber()
+
+	var payload interface{}
+	if err := decoder.Decode(&payload); err != nil {
+		return nil, err
+	}
+
+	return payload, nil
+}
+
+// GetEventPayloadField returns the field of a decoded event payload at a path of dot separated keys (e.g. pull_request.head.ref).
+// Array elements are accessed by their index (e.g. pull_request.labels.0.name) and the empty path is the whole payload.
+// The boolean result is false when there is no field at the path.
+func GetEventPayloadField(payload interface{}, path string) (interface{}, bool) {
+	field := payload
+
+	if path == "" {
+		return field, true
+	}
+
+	for _, key := range strings.Split(path, ".") {
+		switch node := field.(type) {
+		case map[string]interface{}:
+			value, ok := node[key]
+			if !ok {
+				return nil, false
+			}
+			field = value
+		case []interface{}:
+			index, err := strconv.Atoi(key)
+			if err != nil || index < 0 || index >= len(node) {
+				return nil, false
+			}
+			field = node[index]
+		default:
+			return nil, false
+		}
+	}
+
+	return field, true
+}
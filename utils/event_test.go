@@ -0,0 +1,92 @@
+// Copyright 2022 Explore.dev Unipessoal Lda. All Rights Reserved.
+// Use of this source code is governed by a license that can be
+// found in the LICENSE file.
+
+package utils_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/reviewpad/reviewpad/v3/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+var mockedRawEventPayload = []byte(`{
+	"action": "opened",
+	"pull_request": {
+		"id": 1234567890123,
+		"head": {"ref": "feature"},
+		"labels": [{"name": "bug"}]
+	}
+}`)
+
+func decodeMockedEventPayload(t *testing.T) interface{} {
+	payload, err := utils.DecodeEventPayload(mockedRawEventPayload)
+	assert.Nil(t, err)
+
+	return payload
+}
+
+func TestDecodeEventPayload(t *testing.T) {
+	gotPayload, err := utils.DecodeEventPayload([]byte(`{"action": "opened", "number": 3}`))
+
+	wantPayload := map[string]interface{}{
+		"action": "opened",
+		"number": json.Number("3"),
+	}
+
+	assert.Nil(t, err)
+	assert.Equal(t, wantPayload, gotPayload)
+}
+
+func TestDecodeEventPayload_WhenJSONIsInvalid(t *testing.T) {
+	gotPayload, err := utils.DecodeEventPayload([]byte(`{"action": `))
+
+	assert.NotNil(t, err)
+	assert.Nil(t, gotPayload)
+}
+
+func TestGetEventPayloadField(t *testing.T) {
+	gotField, gotOk := utils.GetEventPayloadField(decodeMockedEventPayload(t), "pull_request.head.ref")
+
+	assert.True(t, gotOk)
+	assert.Equal(t, "feature", gotField)
+}
+
+func TestGetEventPayloadField_WhenArrayElement(t *testing.T) {
+	gotField, gotOk := utils.GetEventPayloadField(decodeMockedEventPayload(t), "pull_request.labels.0.name")
+
+	assert.True(t, gotOk)
+	assert.Equal(t, "bug", gotField)
+}
+
+func TestGetEventPayloadField_WhenNumber(t *testing.T) {
+	gotField, gotOk := utils.GetEventPayloadField(decodeMockedEventPayload(t), "pull_request.id")
+
+	assert.True(t, gotOk)
+	assert.Equal(t, json.Number("1234567890123"), gotField)
+}
+
+func TestGetEventPayloadField_WhenFieldDoesNotExist(t *testing.T) {
+	gotField, gotOk := utils.GetEventPayloadField(decodeMockedEventPayload(t), "review.state")
+
+	assert.False(t, gotOk)
+	assert.Nil(t, gotField)
+}
+
+func TestGetEventPayloadField_WhenIndexIsOutOfBounds(t *testing.T) {
+	gotField, gotOk := utils.GetEventPayloadField(decodeMockedEventPayload(t), "pull_request.labels.1.name")
+
+	assert.False(t, gotOk)
+	assert.Nil(t, gotField)
+}
+
+func TestGetEventPayloadField_WhenPathIsEmpty(t *testing.T) {
+	payload := map[string]interface{}{"action": "opened"}
+
+	gotField, gotOk := utils.GetEventPayloadField(payload, "")
+
+	assert.True(t, gotOk)
+	assert.Equal(t, payload, gotField)
+}